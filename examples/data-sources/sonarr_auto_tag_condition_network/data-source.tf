@@ -0,0 +1,15 @@
+data "sonarr_auto_tag_condition_network" "example" {
+  name     = "Example"
+  negate   = false
+  required = false
+  value    = "HBO"
+}
+
+resource "sonarr_auto_tag" "example" {
+  remove_tags_automatically = false
+  name                      = "Example"
+
+  tags = [1, 2]
+
+  specifications = [data.sonarr_auto_tag_condition_network.example]
+}
@@ -0,0 +1,16 @@
+data "sonarr_auto_tag_condition_year" "example" {
+  name     = "Example"
+  negate   = false
+  required = false
+  min      = 1990
+  max      = 1999
+}
+
+resource "sonarr_auto_tag" "example" {
+  remove_tags_automatically = false
+  name                      = "Example"
+
+  tags = [1, 2]
+
+  specifications = [data.sonarr_auto_tag_condition_year.example]
+}
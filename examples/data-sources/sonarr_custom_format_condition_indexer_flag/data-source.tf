@@ -0,0 +1,13 @@
+data "sonarr_custom_format_condition_indexer_flag" "example" {
+  name         = "Example"
+  negate       = false
+  required     = false
+  indexer_flag = "freeleech"
+}
+
+resource "sonarr_custom_format" "example" {
+  include_custom_format_when_renaming = false
+  name                                = "Example"
+
+  specifications = [data.sonarr_custom_format_condition_indexer_flag.example]
+}
@@ -0,0 +1,13 @@
+data "sonarr_custom_format_condition_release_type" "example" {
+  name         = "Example"
+  negate       = false
+  required     = false
+  release_type = "season_pack"
+}
+
+resource "sonarr_custom_format" "example" {
+  include_custom_format_when_renaming = false
+  name                                = "Example"
+
+  specifications = [data.sonarr_custom_format_condition_release_type.example]
+}
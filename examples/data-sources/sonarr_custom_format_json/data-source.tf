@@ -0,0 +1,9 @@
+data "sonarr_custom_format_json" "x265" {
+  json = file("${path.module}/x265-hd.json")
+}
+
+resource "sonarr_custom_format" "x265" {
+  name                                = data.sonarr_custom_format_json.x265.name
+  include_custom_format_when_renaming = data.sonarr_custom_format_json.x265.include_custom_format_when_renaming
+  specifications                      = data.sonarr_custom_format_json.x265.specifications
+}
\ No newline at end of file
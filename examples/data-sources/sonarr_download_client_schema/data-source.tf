@@ -0,0 +1,3 @@
+data "sonarr_download_client_schema" "example" {
+  implementation = "Transmission"
+}
@@ -0,0 +1,3 @@
+data "sonarr_import_list_schema" "example" {
+  implementation = "SonarrImport"
+}
@@ -0,0 +1,3 @@
+data "sonarr_indexer_schema" "example" {
+  implementation = "Newznab"
+}
@@ -0,0 +1,3 @@
+data "sonarr_metadata_schema" "example" {
+  implementation = "XbmcMetadata"
+}
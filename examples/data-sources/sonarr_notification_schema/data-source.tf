@@ -0,0 +1,3 @@
+data "sonarr_notification_schema" "example" {
+  implementation = "Webhook"
+}
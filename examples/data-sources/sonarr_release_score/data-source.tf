@@ -0,0 +1,17 @@
+data "sonarr_quality_profile" "example" {
+  name = "HD-1080p"
+}
+
+data "sonarr_release_score" "example" {
+  title              = "Show.S01.1080p.WEB-DL.x265-GRP"
+  size               = 2147483648
+  indexer_flags      = ["freeleech"]
+  quality_profile_id = data.sonarr_quality_profile.example.id
+}
+
+check "x265_penalized" {
+  assert {
+    condition     = data.sonarr_release_score.example.score < 0
+    error_message = "x265 releases must not be preferred."
+  }
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+# import using the API/UI ID
+terraform import sonarr_download_client_freebox.example 1
+
+# import using the name
+terraform import sonarr_download_client_freebox.example "name=Example"
\ No newline at end of file
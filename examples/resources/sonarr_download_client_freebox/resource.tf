@@ -0,0 +1,12 @@
+resource "sonarr_download_client_freebox" "example" {
+  enable          = true
+  priority        = 1
+  name            = "Example"
+  host            = "mafreebox.freebox.fr"
+  port            = 443
+  use_ssl         = true
+  app_id          = "fr.freebox.sonarr"
+  app_token       = "AppToken"
+  category        = "sonarr-tv"
+  recent_priority = "first"
+}
\ No newline at end of file
@@ -1,2 +1,5 @@
 # import using the API/UI ID
-terraform import sonarr_download_client_qbittorrent.example 1
\ No newline at end of file
+terraform import sonarr_download_client_qbittorrent.example 1
+
+# import using the name
+terraform import sonarr_download_client_qbittorrent.example "name=Example"
\ No newline at end of file
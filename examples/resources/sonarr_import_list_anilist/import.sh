@@ -0,0 +1,5 @@
+# import using the API/UI ID
+terraform import sonarr_import_list_anilist.example 1
+
+# import using the name
+terraform import sonarr_import_list_anilist.example "name=Example"
\ No newline at end of file
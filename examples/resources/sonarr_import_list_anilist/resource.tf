@@ -0,0 +1,13 @@
+resource "sonarr_import_list_anilist" "example" {
+  name                 = "Example"
+  enable_automatic_add = true
+  season_folder        = true
+  should_monitor       = "all"
+  series_type          = "anime"
+  root_folder_path     = sonarr_root_folder.example.path
+  quality_profile_id   = 1
+  username             = "Example"
+  access_token         = "Token"
+  import_current       = true
+  import_planning      = true
+}
\ No newline at end of file
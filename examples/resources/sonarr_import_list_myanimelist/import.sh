@@ -0,0 +1,5 @@
+# import using the API/UI ID
+terraform import sonarr_import_list_myanimelist.example 1
+
+# import using the name
+terraform import sonarr_import_list_myanimelist.example "name=Example"
\ No newline at end of file
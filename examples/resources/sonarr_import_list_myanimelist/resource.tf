@@ -0,0 +1,11 @@
+resource "sonarr_import_list_myanimelist" "example" {
+  name                 = "Example"
+  enable_automatic_add = true
+  season_folder        = true
+  should_monitor       = "all"
+  series_type          = "anime"
+  root_folder_path     = sonarr_root_folder.example.path
+  quality_profile_id   = 1
+  access_token         = "Token"
+  list_status          = 1
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "sonarr_metadata_emby" "example" {
+  enable          = true
+  name            = "Example"
+  series_metadata = true
+}
\ No newline at end of file
@@ -0,0 +1,5 @@
+resource "sonarr_metadata_plex" "example" {
+  enable                 = true
+  name                   = "Example"
+  series_plex_match_file = true
+}
\ No newline at end of file
@@ -1,12 +1,12 @@
 resource "sonarr_naming" "example" {
-  rename_episodes            = true
-  replace_illegal_characters = true
-  multi_episode_style        = 0
-  colon_replacement_format   = 4
-  daily_episode_format       = "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}"
-  anime_episode_format       = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
-  series_folder_format       = "{Series Title}"
-  season_folder_format       = "Season {season}"
-  specials_folder_format     = "Specials"
-  standard_episode_format    = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
+  rename_episodes               = true
+  replace_illegal_characters    = true
+  multi_episode_style_name      = "extend"
+  colon_replacement_format_name = "smart"
+  daily_episode_format          = "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}"
+  anime_episode_format          = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
+  series_folder_format          = "{Series Title}"
+  season_folder_format          = "Season {season}"
+  specials_folder_format        = "Specials"
+  standard_episode_format       = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
 }
\ No newline at end of file
@@ -11,9 +11,9 @@ resource "sonarr_notification_apprise" "example" {
   include_health_warnings = false
   name                    = "Example"
 
-  notification_type = 1
-  server_url        = "https://apprise.go"
-  auth_username     = "User"
-  auth_password     = "Password"
-  field_tags        = ["warning", "skull"]
+  notification_type_name = "success"
+  server_url             = "https://apprise.go"
+  auth_username          = "User"
+  auth_password          = "Password"
+  field_tags             = ["warning", "skull"]
 }
\ No newline at end of file
@@ -0,0 +1,16 @@
+resource "sonarr_notification_notifiarr" "example" {
+  on_grab                            = false
+  on_download                        = true
+  on_upgrade                         = true
+  on_rename                          = false
+  on_series_delete                   = false
+  on_episode_file_delete             = false
+  on_episode_file_delete_for_upgrade = true
+  on_health_issue                    = false
+  on_application_update              = false
+
+  include_health_warnings = false
+  name                    = "Example"
+
+  api_key = "APIKey"
+}
\ No newline at end of file
@@ -0,0 +1,17 @@
+resource "sonarr_notification_pushcut" "example" {
+  on_grab                            = false
+  on_download                        = true
+  on_upgrade                         = true
+  on_series_delete                   = false
+  on_episode_file_delete             = false
+  on_episode_file_delete_for_upgrade = true
+  on_health_issue                    = false
+  on_application_update              = false
+
+  include_health_warnings = false
+  name                    = "Example"
+
+  notification_name = "Sonarr"
+  api_key           = "APIKey"
+  time_sensitive    = true
+}
\ No newline at end of file
@@ -23,4 +23,15 @@ resource "sonarr_quality_profile" "example" {
       ]
     }
   ]
+}
+
+resource "sonarr_quality_profile" "example_names" {
+  name              = "example-1080p"
+  upgrade_allowed   = true
+  allowed_qualities = ["Bluray-1080p", "WEBDL-1080p", "WEBRip-1080p"]
+  cutoff_quality    = "Bluray-1080p"
+
+  format_scores = {
+    "x265 (HD)" = -10000
+  }
 }
\ No newline at end of file
@@ -13,9 +13,13 @@ const (
 	Update                            = "update"
 	Delete                            = "delete"
 	List                              = "list"
+	TestConnectivity                  = "test"
 	ClientError                       = "Client Error"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
+	ConnectivityTestError             = "Connectivity Test Error"
+	ConnectivityTestWarning           = "Connectivity Test Warning"
+	AdoptedResourceWarning            = "Adopted Resource Warning"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
 	UnexpectedResourceConfigureType   = "Unexpected Resource Configure Type"
 	UnexpectedDataSourceConfigureType = "Unexpected DataSource Configure Type"
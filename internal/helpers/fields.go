@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"slices"
@@ -331,3 +332,96 @@ func WriteFields(ctx context.Context, fieldContainer interface{}, fields []sonar
 		}
 	}
 }
+
+// isMapped checks if the API field name is managed by any of the field lists.
+func (f Fields) isMapped(name string) bool {
+	r := reflect.ValueOf(f)
+	for i := range r.NumField() {
+		list, _ := r.Field(i).Interface().([]string)
+		if slices.ContainsFunc(list, func(n string) bool { return strings.EqualFold(n, name) }) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReadExtraFields merges the raw JSON fields into the given sonarr.Field slice, overriding the fields with the same name.
+func ReadExtraFields(extraFields types.String, fields []sonarr.Field) []sonarr.Field {
+	if extraFields.IsNull() || extraFields.IsUnknown() {
+		return fields
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal([]byte(extraFields.ValueString()), &values); err != nil {
+		return fields
+	}
+
+	for name, value := range values {
+		fields = slices.DeleteFunc(fields, func(f sonarr.Field) bool { return f.GetName() == name })
+		fields = append(fields, setField(name, value))
+	}
+
+	return fields
+}
+
+// WriteExtraFields returns the fields not managed by the field lists as raw JSON.
+// If the current value is known, only its fields are returned, to keep it consistent with configuration.
+func WriteExtraFields(current types.String, fields []sonarr.Field, fieldLists Fields) types.String {
+	values := make(map[string]interface{})
+	filter := !current.IsNull() && !current.IsUnknown() && json.Unmarshal([]byte(current.ValueString()), &values) == nil
+
+	for _, f := range fields {
+		name := f.GetName()
+
+		if filter {
+			// Keep current value for sensitive data.
+			if _, ok := values[name]; ok && f.GetValue() != SensitiveValue {
+				values[name] = f.GetValue()
+			}
+
+			continue
+		}
+
+		if !fieldLists.isMapped(name) {
+			values[name] = f.GetValue()
+		}
+	}
+
+	// Keep current value if semantically equal, to preserve formatting.
+	if filter {
+		var currentValues map[string]interface{}
+		if json.Unmarshal([]byte(current.ValueString()), &currentValues) == nil && reflect.DeepEqual(currentValues, values) {
+			return current
+		}
+	}
+
+	output, err := json.Marshal(values)
+	if err != nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(output))
+}
+
+// AttributeName returns the Terraform attribute name managing the API field, if any.
+func AttributeName(name string, fieldContainer interface{}, fieldLists Fields) string {
+	if !fieldLists.isMapped(name) {
+		return ""
+	}
+
+	return StructAttributeName(selectTFName(name), fieldContainer)
+}
+
+// StructAttributeName returns the tfsdk tag of the struct field matching the given name, case insensitive.
+func StructAttributeName(name string, fieldContainer interface{}) string {
+	structType := reflect.TypeOf(fieldContainer).Elem()
+
+	for i := range structType.NumField() {
+		if strings.EqualFold(structType.Field(i).Name, name) {
+			return structType.Field(i).Tag.Get("tfsdk")
+		}
+	}
+
+	return ""
+}
@@ -657,3 +657,133 @@ func TestWriteFields(t *testing.T) {
 		})
 	}
 }
+
+func TestReadExtraFields(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		extraFields types.String
+		expected    map[string]interface{}
+	}{
+		"null": {
+			extraFields: types.StringNull(),
+			expected:    map[string]interface{}{"str": "test"},
+		},
+		"merge": {
+			extraFields: types.StringValue(`{"newField":true}`),
+			expected:    map[string]interface{}{"str": "test", "newField": true},
+		},
+		"override": {
+			extraFields: types.StringValue(`{"str":"other"}`),
+			expected:    map[string]interface{}{"str": "other"},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fields := ReadExtraFields(test.extraFields, []sonarr.Field{setField("str", "test")})
+			output := make(map[string]interface{}, len(fields))
+
+			for _, f := range fields {
+				output[f.GetName()] = f.GetValue()
+			}
+
+			assert.Equal(t, test.expected, output)
+		})
+	}
+}
+
+func TestWriteExtraFields(t *testing.T) {
+	t.Parallel()
+
+	fields := []sonarr.Field{
+		setField("str", "test"),
+		setField("newField", true),
+		setField("secret", SensitiveValue),
+	}
+
+	tests := map[string]struct {
+		current  types.String
+		expected types.String
+	}{
+		"unknown": {
+			current:  types.StringUnknown(),
+			expected: types.StringValue(`{"newField":true,"secret":"********"}`),
+		},
+		"filtered": {
+			current:  types.StringValue(`{"newField":false}`),
+			expected: types.StringValue(`{"newField":true}`),
+		},
+		"sensitive": {
+			current:  types.StringValue(`{ "newField": true, "secret": "password" }`),
+			expected: types.StringValue(`{ "newField": true, "secret": "password" }`),
+		},
+		"missing": {
+			current:  types.StringValue(`{"other":1}`),
+			expected: types.StringValue(`{"other":1}`),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, WriteExtraFields(test.current, fields, Fields{Strings: []string{"str"}}))
+		})
+	}
+}
+
+func TestAttributeName(t *testing.T) {
+	t.Parallel()
+
+	type container struct {
+		Str      types.String `tfsdk:"str"`
+		SeedTime types.Int64  `tfsdk:"seed_time"`
+	}
+
+	fieldLists := Fields{
+		Strings:        []string{"str"},
+		Ints:           []string{"seedTime"},
+		IntsExceptions: []string{"seedCriteria.seedTime"},
+	}
+
+	tests := map[string]struct {
+		name     string
+		expected string
+	}{
+		"mapped": {
+			name:     "str",
+			expected: "str",
+		},
+		"exception": {
+			name:     "seedCriteria.seedTime",
+			expected: "seed_time",
+		},
+		"unmapped": {
+			name:     "other",
+			expected: "",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, AttributeName(test.name, &container{}, fieldLists))
+		})
+	}
+}
+
+func TestStructAttributeName(t *testing.T) {
+	t.Parallel()
+
+	type container struct {
+		URLBase types.String `tfsdk:"url_base"`
+	}
+
+	assert.Equal(t, "url_base", StructAttributeName("UrlBase", &container{}))
+	assert.Equal(t, "", StructAttributeName("Host", &container{}))
+}
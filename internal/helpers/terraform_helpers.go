@@ -4,11 +4,15 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
+// ImportNamePrefix identifies import identifiers to be resolved by name.
+const ImportNamePrefix = "name="
+
 // ImportStatePassthroughIntID is a helper function to set the import
 // identifier to a given state attribute path. The attribute must accept a
 // int value.
@@ -32,3 +36,59 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// FindIDs returns a function listing the IDs of the items with the given name,
+// as expected by ImportStatePassthroughIntIDOrName. Items not satisfying the
+// optional filter, e.g. of another implementation, are skipped.
+func FindIDs[T any](list func() ([]T, error), name func(*T) string, id func(*T) int32, filter func(*T) bool) func(string) ([]int32, error) {
+	return func(value string) ([]int32, error) {
+		items, err := list()
+		if err != nil {
+			return nil, err
+		}
+
+		var ids []int32
+
+		for i := range items {
+			if name(&items[i]) == value && (filter == nil || filter(&items[i])) {
+				ids = append(ids, id(&items[i]))
+			}
+		}
+
+		return ids, nil
+	}
+}
+
+// ImportStatePassthroughIntIDOrName is a helper function to set the import
+// identifier to a given state attribute path. The identifier can be either an
+// int ID or a name prefixed by `name=`, resolved to the ID by the given function.
+func ImportStatePassthroughIntIDOrName(ctx context.Context, attrPath path.Path, req resource.ImportStateRequest, resp *resource.ImportStateResponse, kind string, findIDs func(string) ([]int32, error)) {
+	name, found := strings.CutPrefix(req.ID, ImportNamePrefix)
+	if !found {
+		ImportStatePassthroughIntID(ctx, attrPath, req, resp)
+
+		return
+	}
+
+	ids, err := findIDs(name)
+	if err != nil {
+		resp.Diagnostics.AddError(ClientError, ParseClientError(List, kind, err))
+
+		return
+	}
+
+	switch len(ids) {
+	case 0:
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("No %s found with name '%s'.", kind, name),
+		)
+	case 1:
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, int(ids[0]))...)
+	default:
+		resp.Diagnostics.AddError(
+			UnexpectedImportIdentifier,
+			fmt.Sprintf("Multiple %s found with name '%s' (IDs %v). Import using the ID instead.", kind, name, ids),
+		)
+	}
+}
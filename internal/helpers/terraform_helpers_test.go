@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportStatePassthroughIntIDOrName(t *testing.T) {
+	t.Parallel()
+
+	find := func(name string) ([]int32, error) {
+		switch name {
+		case "single":
+			return []int32{3}, nil
+		case "double":
+			return []int32{3, 4}, nil
+		case "error":
+			return nil, errors.New("other error")
+		default:
+			return nil, nil
+		}
+	}
+
+	tests := map[string]struct {
+		id       string
+		expected int64
+		err      string
+	}{
+		"id": {
+			id:       "5",
+			expected: 5,
+		},
+		"name": {
+			id:       "name=single",
+			expected: 3,
+		},
+		"missing": {
+			id:  "name=missing",
+			err: UnexpectedImportIdentifier,
+		},
+		"multiple": {
+			id:  "name=double",
+			err: UnexpectedImportIdentifier,
+		},
+		"client_error": {
+			id:  "name=error",
+			err: ClientError,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schema.Schema{
+						Attributes: map[string]schema.Attribute{
+							"id": schema.Int64Attribute{Computed: true},
+						},
+					},
+					Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}, nil),
+				},
+			}
+
+			ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), resource.ImportStateRequest{ID: test.id}, resp, "sonarr_tag", find)
+
+			if test.err != "" {
+				assert.True(t, resp.Diagnostics.HasError())
+				assert.Equal(t, test.err, resp.Diagnostics.Errors()[0].Summary())
+
+				return
+			}
+
+			var id int64
+
+			assert.False(t, resp.Diagnostics.HasError())
+			resp.State.GetAttribute(ctx, path.Root("id"), &id)
+			assert.Equal(t, test.expected, id)
+		})
+	}
+}
+
+func TestFindIDs(t *testing.T) {
+	t.Parallel()
+
+	type item struct {
+		name string
+		kind string
+		id   int32
+	}
+
+	list := func() ([]item, error) {
+		return []item{{"a", "x", 1}, {"b", "x", 2}, {"a", "y", 3}}, nil
+	}
+	name := func(i *item) string { return i.name }
+	id := func(i *item) int32 { return i.id }
+
+	ids, err := FindIDs(list, name, id, nil)("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1, 3}, ids)
+
+	ids, err = FindIDs(list, name, id, func(i *item) bool { return i.kind == "y" })("a")
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{3}, ids)
+
+	_, err = FindIDs(func() ([]item, error) { return nil, errors.New("list error") }, name, id, nil)("a")
+	assert.Error(t, err)
+}
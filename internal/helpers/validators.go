@@ -0,0 +1,176 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp/syntax"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = jsonObjectValidator{}
+	_ validator.String = urlValidator{}
+	_ validator.String = hostValidator{}
+	_ validator.String = termRegexValidator{}
+)
+
+// errNotHTTPURL is returned for parsable URLs not using the http or https scheme or missing the host.
+var errNotHTTPURL = errors.New("expected an absolute http or https URL")
+
+// structuralRegexErrors are the regular expression errors not depending on the engine.
+// Sonarr uses .NET regular expressions, which support more syntax (e.g. lookarounds)
+// than Go, so only broken expressions are reported.
+var structuralRegexErrors = []syntax.ErrorCode{
+	syntax.ErrInvalidCharRange,
+	syntax.ErrInvalidRepeatOp,
+	syntax.ErrInvalidRepeatSize,
+	syntax.ErrMissingBracket,
+	syntax.ErrMissingParen,
+	syntax.ErrMissingRepeatArgument,
+	syntax.ErrTrailingBackslash,
+	syntax.ErrUnexpectedParen,
+}
+
+// jsonObjectValidator validates that a string is a JSON object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(_ context.Context) string {
+	return "value must be a valid JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]interface{}
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("Attribute %s must be a valid JSON object, got error: %s", req.Path, err),
+		)
+	}
+}
+
+// JSONObject returns a validator which ensures that the string is a valid JSON object.
+func JSONObject() validator.String {
+	return jsonObjectValidator{}
+}
+
+// urlValidator validates that a string is an absolute HTTP URL.
+// It only warns for now, since configurations accepted so far would otherwise break.
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	// empty strings are used to unset optional URLs
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.ValueString() == "" {
+		return
+	}
+
+	value, err := url.Parse(req.ConfigValue.ValueString())
+	if err == nil && ((value.Scheme != "http" && value.Scheme != "https") || value.Host == "") {
+		err = errNotHTTPURL
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Attribute %s should be an absolute http or https URL, this will be an error in a future release, got error: %s", req.Path, err),
+		)
+	}
+}
+
+// URL returns a validator which warns when the string is not an absolute HTTP URL.
+func URL() validator.String {
+	return urlValidator{}
+}
+
+// hostValidator validates that a string is a bare host name or IP address.
+// It only warns for now, since configurations accepted so far would otherwise break.
+type hostValidator struct{}
+
+func (v hostValidator) Description(_ context.Context) string {
+	return "value must be a host name or an IP address, without scheme or path"
+}
+
+func (v hostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if value == "" || strings.ContainsAny(value, "/ \t\n") {
+		resp.Diagnostics.AddAttributeWarning(
+			req.Path,
+			"Invalid Host",
+			fmt.Sprintf("Attribute %s should be a host name or an IP address, without scheme or path, this will be an error in a future release, got: %s", req.Path, value),
+		)
+	}
+}
+
+// Host returns a validator which warns when the string is not a bare host name or IP address.
+func Host() validator.String {
+	return hostValidator{}
+}
+
+// termRegexValidator validates the regular expressions used as terms, i.e. the ones enclosed in slashes.
+type termRegexValidator struct{}
+
+func (v termRegexValidator) Description(_ context.Context) string {
+	return "terms enclosed in slashes must be valid regular expressions"
+}
+
+func (v termRegexValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v termRegexValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	term := strings.TrimSuffix(req.ConfigValue.ValueString(), "i")
+	if len(term) < 2 || !strings.HasPrefix(term, "/") || !strings.HasSuffix(term, "/") {
+		return
+	}
+
+	var syntaxErr *syntax.Error
+
+	_, err := syntax.Parse(term[1:len(term)-1], syntax.Perl)
+	if errors.As(err, &syntaxErr) && slices.Contains(structuralRegexErrors, syntaxErr.Code) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Attribute %s must be a valid regular expression, got error: %s", req.Path, err),
+		)
+	}
+}
+
+// TermRegex returns a validator which ensures that the terms enclosed in slashes are valid regular expressions.
+func TermRegex() validator.String {
+	return termRegexValidator{}
+}
@@ -0,0 +1,173 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONObject(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: false,
+		},
+		"object": {
+			value:    types.StringValue(`{"field":1}`),
+			expected: false,
+		},
+		"list": {
+			value:    types.StringValue(`[1]`),
+			expected: true,
+		},
+		"invalid": {
+			value:    types.StringValue(`{field}`),
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			JSONObject().ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: test.value}, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+func TestURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: false,
+		},
+		"empty": {
+			value:    types.StringValue(""),
+			expected: false,
+		},
+		"url": {
+			value:    types.StringValue("https://sonarr.local:8989/api"),
+			expected: false,
+		},
+		"scheme": {
+			value:    types.StringValue("ftp://sonarr.local"),
+			expected: true,
+		},
+		"relative": {
+			value:    types.StringValue("sonarr.local/api"),
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			URL().ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: test.value}, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.expected, resp.Diagnostics.WarningsCount() > 0)
+		})
+	}
+}
+
+func TestHost(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"null": {
+			value:    types.StringNull(),
+			expected: false,
+		},
+		"name": {
+			value:    types.StringValue("qbittorrent.local"),
+			expected: false,
+		},
+		"ipv6": {
+			value:    types.StringValue("::1"),
+			expected: false,
+		},
+		"empty": {
+			value:    types.StringValue(""),
+			expected: true,
+		},
+		"url": {
+			value:    types.StringValue("http://qbittorrent.local"),
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			Host().ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: test.value}, &resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.Equal(t, test.expected, resp.Diagnostics.WarningsCount() > 0)
+		})
+	}
+}
+
+func TestTermRegex(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		value    types.String
+		expected bool
+	}{
+		"term": {
+			value:    types.StringValue("x265("),
+			expected: false,
+		},
+		"regex": {
+			value:    types.StringValue("/\\b(x|h)265\\b/i"),
+			expected: false,
+		},
+		"lookahead": {
+			value:    types.StringValue("/^(?!.*dub).*$/"),
+			expected: false,
+		},
+		"unclosed": {
+			value:    types.StringValue("/x265(/"),
+			expected: true,
+		},
+		"repeat": {
+			value:    types.StringValue("/*265/"),
+			expected: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := validator.StringResponse{}
+			TermRegex().ValidateString(context.Background(), validator.StringRequest{Path: path.Root("test"), ConfigValue: test.value}, &resp)
+			assert.Equal(t, test.expected, resp.Diagnostics.HasError())
+		})
+	}
+}
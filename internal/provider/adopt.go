@@ -0,0 +1,170 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+var errAdoptMismatch = errors.New("an existing object with the same name cannot be adopted")
+
+// checkAdoptable fails when the existing object with the same name is of a different kind, to avoid overwriting it.
+func checkAdoptable(name, implementation, existingImplementation, protocol, existingProtocol string) error {
+	if implementation != existingImplementation {
+		return fmt.Errorf("%w: %q has implementation %s instead of %s", errAdoptMismatch, name, existingImplementation, implementation)
+	}
+
+	if protocol != existingProtocol {
+		return fmt.Errorf("%w: %q has protocol %s instead of %s", errAdoptMismatch, name, existingProtocol, protocol)
+	}
+
+	return nil
+}
+
+// warnAdopted makes the takeover of an existing object visible in the apply output.
+func warnAdopted(diags *diag.Diagnostics, kind, name string, id int32) {
+	diags.AddWarning(helpers.AdoptedResourceWarning, fmt.Sprintf("Adopted existing %s %q with ID %d.", kind, name, id))
+}
+
+// createDownloadClient creates a download client or, if adopt is set, updates the existing one with the same name and implementation.
+func createDownloadClient(auth context.Context, client *sonarr.APIClient, request *sonarr.DownloadClientResource, adopt bool, diags *diag.Diagnostics) (*sonarr.DownloadClientResource, error) {
+	if adopt {
+		downloadClients, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, downloadClient := range downloadClients {
+			if downloadClient.GetName() == request.GetName() {
+				if err := checkAdoptable(request.GetName(), request.GetImplementation(), downloadClient.GetImplementation(), string(request.GetProtocol()), string(downloadClient.GetProtocol())); err != nil {
+					return nil, err
+				}
+
+				request.SetId(downloadClient.GetId())
+				response, _, err := client.DownloadClientAPI.UpdateDownloadClient(auth, request.GetId()).DownloadClientResource(*request).Execute()
+				if err == nil {
+					warnAdopted(diags, "download client", request.GetName(), request.GetId())
+				}
+
+				return response, err
+			}
+		}
+	}
+
+	response, _, err := client.DownloadClientAPI.CreateDownloadClient(auth).DownloadClientResource(*request).Execute()
+
+	return response, err
+}
+
+// createIndexer creates an indexer or, if adopt is set, updates the existing one with the same name and implementation.
+func createIndexer(auth context.Context, client *sonarr.APIClient, request *sonarr.IndexerResource, adopt bool, diags *diag.Diagnostics) (*sonarr.IndexerResource, error) {
+	if adopt {
+		indexers, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, indexer := range indexers {
+			if indexer.GetName() == request.GetName() {
+				if err := checkAdoptable(request.GetName(), request.GetImplementation(), indexer.GetImplementation(), string(request.GetProtocol()), string(indexer.GetProtocol())); err != nil {
+					return nil, err
+				}
+
+				request.SetId(indexer.GetId())
+				response, _, err := client.IndexerAPI.UpdateIndexer(auth, request.GetId()).IndexerResource(*request).Execute()
+				if err == nil {
+					warnAdopted(diags, "indexer", request.GetName(), request.GetId())
+				}
+
+				return response, err
+			}
+		}
+	}
+
+	response, _, err := client.IndexerAPI.CreateIndexer(auth).IndexerResource(*request).Execute()
+
+	return response, err
+}
+
+// createNotification creates a notification or, if adopt is set, updates the existing one with the same name and implementation.
+func createNotification(auth context.Context, client *sonarr.APIClient, request *sonarr.NotificationResource, adopt bool, diags *diag.Diagnostics) (*sonarr.NotificationResource, error) {
+	if adopt {
+		notifications, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, notification := range notifications {
+			if notification.GetName() == request.GetName() {
+				if err := checkAdoptable(request.GetName(), request.GetImplementation(), notification.GetImplementation(), "", ""); err != nil {
+					return nil, err
+				}
+
+				request.SetId(notification.GetId())
+				response, _, err := client.NotificationAPI.UpdateNotification(auth, request.GetId()).NotificationResource(*request).Execute()
+				if err == nil {
+					warnAdopted(diags, "notification", request.GetName(), request.GetId())
+				}
+
+				return response, err
+			}
+		}
+	}
+
+	response, _, err := client.NotificationAPI.CreateNotification(auth).NotificationResource(*request).Execute()
+
+	return response, err
+}
+
+// createQualityProfile creates a quality profile or, if adopt is set, updates the existing one with the same name.
+func createQualityProfile(auth context.Context, client *sonarr.APIClient, request *sonarr.QualityProfileResource, adopt bool, diags *diag.Diagnostics) (*sonarr.QualityProfileResource, error) {
+	if adopt {
+		profiles, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, profile := range profiles {
+			if profile.GetName() == request.GetName() {
+				request.SetId(profile.GetId())
+				response, _, err := client.QualityProfileAPI.UpdateQualityProfile(auth, strconv.Itoa(int(request.GetId()))).QualityProfileResource(*request).Execute()
+				if err == nil {
+					warnAdopted(diags, "quality profile", request.GetName(), request.GetId())
+				}
+
+				return response, err
+			}
+		}
+	}
+
+	response, _, err := client.QualityProfileAPI.CreateQualityProfile(auth).QualityProfileResource(*request).Execute()
+
+	return response, err
+}
+
+// createTag creates a tag or, if adopt is set, returns the existing one with the same label.
+func createTag(auth context.Context, client *sonarr.APIClient, request *sonarr.TagResource, adopt bool, diags *diag.Diagnostics) (*sonarr.TagResource, error) {
+	if adopt {
+		tags, _, err := client.TagAPI.ListTag(auth).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range tags {
+			if tag.GetLabel() == request.GetLabel() {
+				warnAdopted(diags, "tag", tag.GetLabel(), tag.GetId())
+
+				return &tag, nil
+			}
+		}
+	}
+
+	response, _, err := client.TagAPI.CreateTag(auth).TagResource(*request).Execute()
+
+	return response, err
+}
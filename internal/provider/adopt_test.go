@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateDownloadClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name           string
+		implementation string
+		expected       string
+		adopt          bool
+		warning        bool
+	}{
+		"adopt_existing": {
+			name:           "existing",
+			implementation: "Transmission",
+			adopt:          true,
+			expected:       http.MethodPut,
+			warning:        true,
+		},
+		"adopt_missing": {
+			name:           "missing",
+			implementation: "Transmission",
+			adopt:          true,
+			expected:       http.MethodPost,
+		},
+		"adopt_other_implementation": {
+			name:           "existing",
+			implementation: "QBittorrent",
+			adopt:          true,
+		},
+		"no_adopt": {
+			name:           "existing",
+			implementation: "Transmission",
+			adopt:          false,
+			expected:       http.MethodPost,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var method string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+
+				switch r.Method {
+				case http.MethodGet:
+					_, _ = w.Write([]byte(`[{"id":3,"name":"existing","implementation":"Transmission","protocol":"torrent"}]`))
+				default:
+					method = r.Method
+					_, _ = w.Write([]byte(`{"id":3,"name":"` + test.name + `"}`))
+				}
+			}))
+			defer server.Close()
+
+			client, auth := testFakeClient(server.URL)
+			request := sonarr.NewDownloadClientResource()
+			request.SetName(test.name)
+			request.SetImplementation(test.implementation)
+			request.SetProtocol(sonarr.DOWNLOADPROTOCOL_TORRENT)
+
+			var diags diag.Diagnostics
+
+			response, err := createDownloadClient(auth, client, request, test.adopt, &diags)
+			if test.expected == "" {
+				assert.ErrorIs(t, err, errAdoptMismatch)
+				assert.Empty(t, method)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.name, response.GetName())
+			assert.Equal(t, test.expected, method)
+			assert.Equal(t, test.warning, diags.WarningsCount() == 1)
+		})
+	}
+}
+
+func TestCreateTag(t *testing.T) {
+	t.Parallel()
+
+	created := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodPost {
+			created = true
+		}
+
+		_, _ = w.Write([]byte(`[{"id":3,"label":"existing"}]`))
+	}))
+	defer server.Close()
+
+	client, auth := testFakeClient(server.URL)
+	request := sonarr.NewTagResource()
+	request.SetLabel("existing")
+
+	var diags diag.Diagnostics
+
+	response, err := createTag(auth, client, request, true, &diags)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, diags.WarningsCount())
+	assert.Equal(t, int32(3), response.GetId())
+	assert.False(t, created)
+}
+
+// testFakeClient returns a client pointing to a test server.
+func testFakeClient(serverURL string) (*sonarr.APIClient, context.Context) {
+	parsedURL, _ := url.Parse(serverURL)
+	auth := context.WithValue(context.Background(), sonarr.ContextServerVariables, map[string]string{
+		"protocol": parsedURL.Scheme,
+		"hostpath": parsedURL.Host,
+	})
+
+	return sonarr.NewAPIClient(sonarr.NewConfiguration()), auth
+}
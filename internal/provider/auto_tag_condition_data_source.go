@@ -19,6 +19,7 @@ const autoTagConditionDataSourceName = "auto_tag_condition"
 
 var autoTagFields = helpers.Fields{
 	Strings: []string{"value"},
+	Ints:    []string{"min", "max"},
 }
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -39,6 +40,8 @@ type AutoTagCondition struct {
 	Name           types.String `tfsdk:"name"`
 	Implementation types.String `tfsdk:"implementation"`
 	Value          types.String `tfsdk:"value"`
+	Min            types.Int64  `tfsdk:"min"`
+	Max            types.Int64  `tfsdk:"max"`
 	Negate         types.Bool   `tfsdk:"negate"`
 	Required       types.Bool   `tfsdk:"required"`
 }
@@ -49,6 +52,8 @@ func (c AutoTagCondition) getType() attr.Type {
 			"name":           types.StringType,
 			"implementation": types.StringType,
 			"value":          types.StringType,
+			"min":            types.Int64Type,
+			"max":            types.Int64Type,
 			"negate":         types.BoolType,
 			"required":       types.BoolType,
 		})
@@ -90,6 +95,16 @@ func (d *AutoTagConditionDataSource) Schema(_ context.Context, _ datasource.Sche
 				Optional:            true,
 				Computed:            true,
 			},
+			"min": schema.Int64Attribute{
+				MarkdownDescription: "Min.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max": schema.Int64Attribute{
+				MarkdownDescription: "Max.",
+				Optional:            true,
+				Computed:            true,
+			},
 		},
 	}
 }
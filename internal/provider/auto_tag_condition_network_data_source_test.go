@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutoTagConditionNetworkDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccAutoTagConditionNetworkDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_auto_tag_condition_network.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_auto_tag_condition_network.test", "name", "Test"),
+					resource.TestCheckResourceAttr("sonarr_auto_tag.test", "specifications.0.value", "HBO")),
+			},
+		},
+	})
+}
+
+const testAccAutoTagConditionNetworkDataSourceConfig = `
+resource "sonarr_tag" "test" {
+	label = "atconditionnetwork"
+}
+
+data  "sonarr_auto_tag_condition_network" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	value = "HBO"
+}
+
+resource "sonarr_auto_tag" "test" {
+	remove_tags_automatically = false
+	name = "TestWithDSNetwork"
+
+	tags = [sonarr_tag.test.id]
+	
+	specifications = [data.sonarr_auto_tag_condition_network.test]	
+}`
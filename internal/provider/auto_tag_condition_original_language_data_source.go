@@ -0,0 +1,138 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const (
+	autoTagConditionOriginalLanguageDataSourceName = "auto_tag_condition_original_language"
+	autoTagConditionOriginalLanguageImplementation = "OriginalLanguageSpecification"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AutoTagConditionOriginalLanguageDataSource{}
+
+func NewAutoTagConditionOriginalLanguageDataSource() datasource.DataSource {
+	return &AutoTagConditionOriginalLanguageDataSource{}
+}
+
+// AutoTagConditionOriginalLanguageDataSource defines the auto_tag_condition_original_language implementation.
+type AutoTagConditionOriginalLanguageDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *AutoTagConditionOriginalLanguageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + autoTagConditionOriginalLanguageDataSourceName
+}
+
+func (d *AutoTagConditionOriginalLanguageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Tags -->\n Auto Tag Condition Original Language data source.\nFor more information refer to [Auto Tag Conditions](https://wiki.servarr.com/sonarr/settings#conditions).",
+		Attributes: map[string]schema.Attribute{
+			"negate": schema.BoolAttribute{
+				MarkdownDescription: "Negate flag.",
+				Required:            true,
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Computed flag.",
+				Required:            true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Implementation.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Specification name.",
+				Required:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Auto tag condition original language ID.",
+				Computed:            true,
+			},
+			"language": schema.StringAttribute{
+				MarkdownDescription: "Language name, resolved to its ID. Alternative to `value`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("value")),
+				},
+			},
+			// Field values
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Language ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AutoTagConditionOriginalLanguageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AutoTagConditionOriginalLanguageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var name, value types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("language"), &name)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("value"), &value)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !name.IsNull() {
+		response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagConditionOriginalLanguageDataSourceName, err))
+
+			return
+		}
+
+		value = types.StringNull()
+
+		for _, item := range response {
+			if item.GetName() == name.ValueString() {
+				value = types.StringValue(strconv.Itoa(int(item.GetId())))
+
+				break
+			}
+		}
+
+		if value.IsNull() {
+			resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(languageDataSourceName, "name", name.ValueString()))
+
+			return
+		}
+	}
+
+	hash, err := hashstructure.Hash(value.ValueString(), hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Create, autoTagConditionOriginalLanguageDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+autoTagConditionOriginalLanguageDataSourceName)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("implementation"), autoTagConditionOriginalLanguageImplementation)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), value)...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(hash))...)
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutoTagConditionOriginalLanguageDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid configuration
+			{
+				Config:      testAccAutoTagConditionOriginalLanguageDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Read testing
+			{
+				Config: testAccAutoTagConditionOriginalLanguageDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_auto_tag_condition_original_language.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_auto_tag_condition_original_language.test", "name", "Test"),
+					resource.TestCheckResourceAttr("sonarr_auto_tag.test", "specifications.0.value", "8")),
+			},
+		},
+	})
+}
+
+const testAccAutoTagConditionOriginalLanguageDataSourceInvalidConfig = `
+data  "sonarr_auto_tag_condition_original_language" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	language = "Japanese"
+	value = "8"
+}`
+
+const testAccAutoTagConditionOriginalLanguageDataSourceConfig = `
+resource "sonarr_tag" "test" {
+	label = "atconditionoriginallanguage"
+}
+
+data  "sonarr_auto_tag_condition_original_language" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	language = "Japanese"
+}
+
+resource "sonarr_auto_tag" "test" {
+	remove_tags_automatically = false
+	name = "TestWithDSOriginalLanguage"
+
+	tags = [sonarr_tag.test.id]
+	
+	specifications = [data.sonarr_auto_tag_condition_original_language.test]	
+}`
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutoTagConditionQualityProfileDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid configuration
+			{
+				Config:      testAccAutoTagConditionQualityProfileDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Unable to find"),
+			},
+			// Read testing
+			{
+				Config: testAccAutoTagConditionQualityProfileDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_auto_tag_condition_quality_profile.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_auto_tag_condition_quality_profile.test", "name", "Test"),
+					resource.TestCheckResourceAttr("sonarr_auto_tag.test", "specifications.0.value", "1")),
+			},
+		},
+	})
+}
+
+const testAccAutoTagConditionQualityProfileDataSourceInvalidConfig = `
+data  "sonarr_auto_tag_condition_quality_profile" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	quality_profile = "Invalid"
+}`
+
+const testAccAutoTagConditionQualityProfileDataSourceConfig = `
+resource "sonarr_tag" "test" {
+	label = "atconditionqualityprofile"
+}
+
+data  "sonarr_auto_tag_condition_quality_profile" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	quality_profile = "Any"
+}
+
+resource "sonarr_auto_tag" "test" {
+	remove_tags_automatically = false
+	name = "TestWithDSQualityProfile"
+
+	tags = [sonarr_tag.test.id]
+	
+	specifications = [data.sonarr_auto_tag_condition_quality_profile.test]	
+}`
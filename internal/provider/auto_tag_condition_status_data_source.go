@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const (
+	autoTagConditionStatusDataSourceName = "auto_tag_condition_status"
+	autoTagConditionStatusImplementation = "StatusSpecification"
+)
+
+// autoTagConditionStatuses are the Sonarr series statuses.
+var autoTagConditionStatuses = intEnum{
+	-1: "deleted",
+	0:  "continuing",
+	1:  "ended",
+	2:  "upcoming",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &AutoTagConditionStatusDataSource{}
+
+func NewAutoTagConditionStatusDataSource() datasource.DataSource {
+	return &AutoTagConditionStatusDataSource{}
+}
+
+// AutoTagConditionStatusDataSource defines the auto_tag_condition_status implementation.
+type AutoTagConditionStatusDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *AutoTagConditionStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + autoTagConditionStatusDataSourceName
+}
+
+func (d *AutoTagConditionStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Tags -->\n Auto Tag Condition Status data source.\nFor more information refer to [Auto Tag Conditions](https://wiki.servarr.com/sonarr/settings#conditions).",
+		Attributes: map[string]schema.Attribute{
+			"negate": schema.BoolAttribute{
+				MarkdownDescription: "Negate flag.",
+				Required:            true,
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Computed flag.",
+				Required:            true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Implementation.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Specification name.",
+				Required:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Auto tag condition status ID.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Series status name. Allowed values: " + autoTagConditionStatuses.description() + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(autoTagConditionStatuses.names()...),
+				},
+			},
+			// Field values
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Series status ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AutoTagConditionStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *AutoTagConditionStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var status types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("status"), &status)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value := strconv.FormatInt(autoTagConditionStatuses.value(status, types.Int64Value(0)).ValueInt64(), 10)
+
+	hash, err := hashstructure.Hash(&value, hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Create, autoTagConditionStatusDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+autoTagConditionStatusDataSourceName)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("implementation"), autoTagConditionStatusImplementation)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), value)...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(hash))...)
+}
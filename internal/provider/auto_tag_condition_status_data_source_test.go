@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutoTagConditionStatusDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid configuration
+			{
+				Config:      testAccAutoTagConditionStatusDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccAutoTagConditionStatusDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_auto_tag_condition_status.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_auto_tag_condition_status.test", "name", "Test"),
+					resource.TestCheckResourceAttr("sonarr_auto_tag.test", "specifications.0.value", "1")),
+			},
+		},
+	})
+}
+
+const testAccAutoTagConditionStatusDataSourceInvalidConfig = `
+data  "sonarr_auto_tag_condition_status" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	status = "invalid"
+}`
+
+const testAccAutoTagConditionStatusDataSourceConfig = `
+resource "sonarr_tag" "test" {
+	label = "atconditionstatus"
+}
+
+data  "sonarr_auto_tag_condition_status" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	status = "ended"
+}
+
+resource "sonarr_auto_tag" "test" {
+	remove_tags_automatically = false
+	name = "TestWithDSStatus"
+
+	tags = [sonarr_tag.test.id]
+	
+	specifications = [data.sonarr_auto_tag_condition_status.test]	
+}`
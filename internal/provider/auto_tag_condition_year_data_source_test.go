@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAutoTagConditionYearDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid configuration
+			{
+				Config:      testAccAutoTagConditionYearDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			// Read testing
+			{
+				Config: testAccAutoTagConditionYearDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_auto_tag_condition_year.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_auto_tag_condition_year.test", "name", "Test"),
+					resource.TestCheckResourceAttr("sonarr_auto_tag.test", "specifications.0.max", "1999")),
+			},
+		},
+	})
+}
+
+const testAccAutoTagConditionYearDataSourceInvalidConfig = `
+data  "sonarr_auto_tag_condition_year" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	min = 2000
+	max = 1990
+}`
+
+const testAccAutoTagConditionYearDataSourceConfig = `
+resource "sonarr_tag" "test" {
+	label = "atconditionyear"
+}
+
+data  "sonarr_auto_tag_condition_year" "test" {
+	name = "Test"
+	negate = false
+	required = false
+	min = 1990
+	max = 1999
+}
+
+resource "sonarr_auto_tag" "test" {
+	remove_tags_automatically = false
+	name = "TestWithDSYear"
+
+	tags = [sonarr_tag.test.id]
+	
+	specifications = [data.sonarr_auto_tag_condition_year.test]	
+}`
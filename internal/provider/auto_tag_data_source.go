@@ -79,6 +79,14 @@ func (d *AutoTagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 							MarkdownDescription: "Value.",
 							Computed:            true,
 						},
+						"min": schema.Int64Attribute{
+							MarkdownDescription: "Min.",
+							Computed:            true,
+						},
+						"max": schema.Int64Attribute{
+							MarkdownDescription: "Max.",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -126,6 +126,16 @@ func (r AutoTagResource) getSpecificationSchema() schema.Schema {
 				Optional:            true,
 				Computed:            true,
 			},
+			"min": schema.Int64Attribute{
+				MarkdownDescription: "Min.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"max": schema.Int64Attribute{
+				MarkdownDescription: "Max.",
+				Optional:            true,
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -235,7 +245,7 @@ func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *AutoTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, autoTagResourceName, findAutoTagIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+autoTagResourceName+": "+req.ID)
 }
 
@@ -275,3 +285,12 @@ func (t *AutoTag) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Aut
 
 	return autoTag
 }
+
+// findAutoTagIDs returns a function listing the IDs of the auto tags with the given name.
+func findAutoTagIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.AutoTaggingResource, error) {
+		response, _, err := client.AutoTaggingAPI.ListAutoTagging(auth).Execute()
+
+		return response, err
+	}, (*sonarr.AutoTaggingResource).GetName, (*sonarr.AutoTaggingResource).GetId, nil)
+}
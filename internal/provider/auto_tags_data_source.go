@@ -94,6 +94,14 @@ func (d *AutoTagsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 										MarkdownDescription: "Value.",
 										Computed:            true,
 									},
+									"min": schema.Int64Attribute{
+										MarkdownDescription: "Min.",
+										Computed:            true,
+									},
+									"max": schema.Int64Attribute{
+										MarkdownDescription: "Max.",
+										Computed:            true,
+									},
 								},
 							},
 						},
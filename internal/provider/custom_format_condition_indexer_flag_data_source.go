@@ -0,0 +1,125 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const (
+	customFormatConditionIndexerFlagDataSourceName = "custom_format_condition_indexer_flag"
+	customFormatConditionIndexerFlagImplementation = "IndexerFlagSpecification"
+)
+
+// customFormatConditionIndexerFlags are the Sonarr indexer flags, which are powers of two.
+var customFormatConditionIndexerFlags = intEnum{
+	1:   "freeleech",
+	2:   "halfleech",
+	4:   "double_upload",
+	8:   "internal",
+	16:  "scene",
+	32:  "freeleech75",
+	64:  "freeleech25",
+	128: "nuked",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomFormatConditionIndexerFlagDataSource{}
+
+func NewCustomFormatConditionIndexerFlagDataSource() datasource.DataSource {
+	return &CustomFormatConditionIndexerFlagDataSource{}
+}
+
+// CustomFormatConditionIndexerFlagDataSource defines the custom_format_condition_indexer_flag implementation.
+type CustomFormatConditionIndexerFlagDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *CustomFormatConditionIndexerFlagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + customFormatConditionIndexerFlagDataSourceName
+}
+
+func (d *CustomFormatConditionIndexerFlagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Profiles -->\n Custom Format Condition Indexer Flag data source.\nFor more information refer to [Custom Format Conditions](https://wiki.servarr.com/sonarr/settings#conditions).",
+		Attributes: map[string]schema.Attribute{
+			"negate": schema.BoolAttribute{
+				MarkdownDescription: "Negate flag.",
+				Required:            true,
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Computed flag.",
+				Required:            true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Implementation.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Specification name.",
+				Required:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Custom format condition indexer flag ID.",
+				Computed:            true,
+			},
+			"indexer_flag": schema.StringAttribute{
+				MarkdownDescription: "Indexer flag name. Allowed values: " + customFormatConditionIndexerFlags.description() + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(customFormatConditionIndexerFlags.names()...),
+				},
+			},
+			// Field values
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Indexer flag ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomFormatConditionIndexerFlagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CustomFormatConditionIndexerFlagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var flag types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("indexer_flag"), &flag)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value := strconv.FormatInt(customFormatConditionIndexerFlags.value(flag, types.Int64Value(0)).ValueInt64(), 10)
+
+	hash, err := hashstructure.Hash(&value, hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Create, customFormatConditionIndexerFlagDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+customFormatConditionIndexerFlagDataSourceName)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("implementation"), customFormatConditionIndexerFlagImplementation)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), value)...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(hash))...)
+}
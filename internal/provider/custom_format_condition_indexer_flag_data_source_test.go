@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCustomFormatConditionIndexerFlagDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid name
+			{
+				Config:      testAccCustomFormatConditionIndexerFlagDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccCustomFormatConditionIndexerFlagDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_custom_format_condition_indexer_flag.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_custom_format_condition_indexer_flag.test", "value", "1"),
+					resource.TestCheckResourceAttr("sonarr_custom_format.test", "specifications.0.value", "1")),
+			},
+		},
+	})
+}
+
+const testAccCustomFormatConditionIndexerFlagDataSourceInvalidConfig = `
+data  "sonarr_custom_format_condition_indexer_flag" "test" {
+	name = "Freeleech"
+	negate = false
+	required = false
+	indexer_flag = "invalid"
+}`
+
+const testAccCustomFormatConditionIndexerFlagDataSourceConfig = `
+data  "sonarr_custom_format_condition_indexer_flag" "test" {
+	name = "Freeleech"
+	negate = false
+	required = false
+	indexer_flag = "freeleech"
+}
+
+resource "sonarr_custom_format" "test" {
+	include_custom_format_when_renaming = false
+	name = "TestWithDSIndexerFlag"
+	
+	specifications = [data.sonarr_custom_format_condition_indexer_flag.test]	
+}`
+
+func TestCustomFormatConditionIndexerFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]int64{
+		"freeleech":     1,
+		"halfleech":     2,
+		"double_upload": 4,
+		"internal":      8,
+		"scene":         16,
+		"freeleech75":   32,
+		"freeleech25":   64,
+		"nuked":         128,
+	}
+	for name, expected := range tests {
+		assert.Equal(t, types.Int64Value(expected), customFormatConditionIndexerFlags.value(types.StringValue(name), types.Int64Null()), name)
+	}
+
+	assert.NotContains(t, customFormatConditionIndexerFlags.names(), "golden")
+	assert.NotContains(t, customFormatConditionIndexerFlags.names(), "approved")
+}
@@ -0,0 +1,121 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const (
+	customFormatConditionReleaseTypeDataSourceName = "custom_format_condition_release_type"
+	customFormatConditionReleaseTypeImplementation = "ReleaseTypeSpecification"
+)
+
+// customFormatConditionReleaseTypes are the Sonarr release types.
+var customFormatConditionReleaseTypes = intEnum{
+	0: "unknown",
+	1: "single_episode",
+	2: "multi_episode",
+	3: "season_pack",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomFormatConditionReleaseTypeDataSource{}
+
+func NewCustomFormatConditionReleaseTypeDataSource() datasource.DataSource {
+	return &CustomFormatConditionReleaseTypeDataSource{}
+}
+
+// CustomFormatConditionReleaseTypeDataSource defines the custom_format_condition_release_type implementation.
+type CustomFormatConditionReleaseTypeDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *CustomFormatConditionReleaseTypeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + customFormatConditionReleaseTypeDataSourceName
+}
+
+func (d *CustomFormatConditionReleaseTypeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Profiles -->\n Custom Format Condition Release Type data source.\nFor more information refer to [Custom Format Conditions](https://wiki.servarr.com/sonarr/settings#conditions).",
+		Attributes: map[string]schema.Attribute{
+			"negate": schema.BoolAttribute{
+				MarkdownDescription: "Negate flag.",
+				Required:            true,
+			},
+			"required": schema.BoolAttribute{
+				MarkdownDescription: "Computed flag.",
+				Required:            true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Implementation.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Specification name.",
+				Required:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Custom format condition release type ID.",
+				Computed:            true,
+			},
+			"release_type": schema.StringAttribute{
+				MarkdownDescription: "Release type name. Allowed values: " + customFormatConditionReleaseTypes.description() + ".",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(customFormatConditionReleaseTypes.names()...),
+				},
+			},
+			// Field values
+			"value": schema.StringAttribute{
+				MarkdownDescription: "Release type ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CustomFormatConditionReleaseTypeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CustomFormatConditionReleaseTypeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var releaseType types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("release_type"), &releaseType)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	value := strconv.FormatInt(customFormatConditionReleaseTypes.value(releaseType, types.Int64Value(0)).ValueInt64(), 10)
+
+	hash, err := hashstructure.Hash(&value, hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Create, customFormatConditionReleaseTypeDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+customFormatConditionReleaseTypeDataSourceName)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("implementation"), customFormatConditionReleaseTypeImplementation)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), value)...)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), int64(hash))...)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCustomFormatConditionReleaseTypeDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid name
+			{
+				Config:      testAccCustomFormatConditionReleaseTypeDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Read testing
+			{
+				Config: testAccCustomFormatConditionReleaseTypeDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_custom_format_condition_release_type.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_custom_format_condition_release_type.test", "value", "3"),
+					resource.TestCheckResourceAttr("sonarr_custom_format.test", "specifications.0.value", "3")),
+			},
+		},
+	})
+}
+
+const testAccCustomFormatConditionReleaseTypeDataSourceInvalidConfig = `
+data  "sonarr_custom_format_condition_release_type" "test" {
+	name = "SeasonPack"
+	negate = false
+	required = false
+	release_type = "invalid"
+}`
+
+const testAccCustomFormatConditionReleaseTypeDataSourceConfig = `
+data  "sonarr_custom_format_condition_release_type" "test" {
+	name = "SeasonPack"
+	negate = false
+	required = false
+	release_type = "season_pack"
+}
+
+resource "sonarr_custom_format" "test" {
+	include_custom_format_when_renaming = false
+	name = "TestWithDSReleaseType"
+	
+	specifications = [data.sonarr_custom_format_condition_release_type.test]	
+}`
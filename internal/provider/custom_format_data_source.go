@@ -47,6 +47,10 @@ func (d *CustomFormatDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Custom Format ID.",
 				Computed:            true,
 			},
+			"export_json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON, in the same shape of the Sonarr UI export. It can be parsed back by `sonarr_custom_format_json`.",
+				Computed:            true,
+			},
 			"specifications": schema.SetNestedAttribute{
 				MarkdownDescription: "Specifications.",
 				Computed:            true,
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const customFormatJSONDataSourceName = "custom_format_json"
+
+var (
+	errCustomFormatJSONName   = errors.New("missing name")
+	errCustomFormatJSONFields = errors.New("fields must be an object or a list of fields")
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CustomFormatJSONDataSource{}
+
+func NewCustomFormatJSONDataSource() datasource.DataSource {
+	return &CustomFormatJSONDataSource{}
+}
+
+// CustomFormatJSONDataSource defines the custom_format_json implementation.
+// It parses the JSON offline, without calling Sonarr.
+type CustomFormatJSONDataSource struct{}
+
+// CustomFormatJSON describes the custom format JSON data model.
+type CustomFormatJSON struct {
+	Specifications                  types.Set    `tfsdk:"specifications"`
+	JSON                            types.String `tfsdk:"json"`
+	Name                            types.String `tfsdk:"name"`
+	ID                              types.Int64  `tfsdk:"id"`
+	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
+}
+
+// customFormatExport is the custom format exported by the Sonarr UI and published by the TRaSH guides.
+type customFormatExport struct {
+	Name                            string                            `json:"name"`
+	Specifications                  []customFormatExportSpecification `json:"specifications"`
+	IncludeCustomFormatWhenRenaming bool                              `json:"includeCustomFormatWhenRenaming"`
+}
+
+// customFormatExportSpecification has the fields either as object, as exported, or as list, as returned by the API.
+type customFormatExportSpecification struct {
+	Name           string          `json:"name"`
+	Implementation string          `json:"implementation"`
+	Fields         json.RawMessage `json:"fields"`
+	Negate         bool            `json:"negate"`
+	Required       bool            `json:"required"`
+}
+
+func (d *CustomFormatJSONDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + customFormatJSONDataSourceName
+}
+
+func (d *CustomFormatJSONDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:Profiles -->\nCustom Format JSON data source, parsing a [Custom Format](../resources/custom_format) exported by Sonarr or published by the [TRaSH guides](https://trash-guides.info/Sonarr/sonarr-collection-of-custom-formats/).\nIts attributes can be assigned to the `sonarr_custom_format` ones.",
+		Attributes: map[string]schema.Attribute{
+			"json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON, e.g. `file(\"x265.json\")`. Numeric field values, like source and resolution, are converted to string.",
+				Required:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
+			"include_custom_format_when_renaming": schema.BoolAttribute{
+				MarkdownDescription: "Include custom format when renaming flag.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Custom Format name.",
+				Computed:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Custom Format JSON ID.",
+				Computed:            true,
+			},
+			"specifications": schema.SetNestedAttribute{
+				MarkdownDescription: "Specifications.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"negate": schema.BoolAttribute{
+							MarkdownDescription: "Negate flag.",
+							Computed:            true,
+						},
+						"required": schema.BoolAttribute{
+							MarkdownDescription: "Required flag.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Specification name.",
+							Computed:            true,
+						},
+						"implementation": schema.StringAttribute{
+							MarkdownDescription: "Implementation.",
+							Computed:            true,
+						},
+						// Field values
+						"value": schema.StringAttribute{
+							MarkdownDescription: "Value.",
+							Computed:            true,
+						},
+						"min": schema.Int64Attribute{
+							MarkdownDescription: "Min.",
+							Computed:            true,
+						},
+						"max": schema.Int64Attribute{
+							MarkdownDescription: "Max.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CustomFormatJSONDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *CustomFormatJSON
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customFormat, err := parseCustomFormatJSON(data.JSON.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("json"), helpers.DataSourceError, fmt.Sprintf("Unable to parse %s, got error: %s", customFormatJSONDataSourceName, err))
+
+		return
+	}
+
+	hash, err := hashstructure.Hash(data.JSON.ValueString(), hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Read, customFormatJSONDataSourceName, err))
+
+		return
+	}
+
+	data.write(ctx, customFormat, &resp.Diagnostics)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.Int64Value(int64(hash))
+
+	tflog.Trace(ctx, "read "+customFormatJSONDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (c *CustomFormatJSON) write(ctx context.Context, customFormat *sonarr.CustomFormatResource, diags *diag.Diagnostics) {
+	var format CustomFormat
+
+	format.write(ctx, customFormat, diags)
+	c.Name = format.Name
+	c.IncludeCustomFormatWhenRenaming = format.IncludeCustomFormatWhenRenaming
+	c.Specifications = format.Specifications
+}
+
+// parseCustomFormatJSON converts the exported custom format into the API one.
+func parseCustomFormatJSON(data string) (*sonarr.CustomFormatResource, error) {
+	var export customFormatExport
+	if err := json.Unmarshal([]byte(data), &export); err != nil {
+		return nil, err
+	}
+
+	if export.Name == "" {
+		return nil, errCustomFormatJSONName
+	}
+
+	specs := make([]sonarr.CustomFormatSpecificationSchema, len(export.Specifications))
+
+	for n, s := range export.Specifications {
+		fields, err := s.fields()
+		if err != nil {
+			return nil, fmt.Errorf("specification %q: %w", s.Name, err)
+		}
+
+		specs[n] = *sonarr.NewCustomFormatSpecificationSchema()
+		specs[n].SetName(s.Name)
+		specs[n].SetImplementation(s.Implementation)
+		specs[n].SetNegate(s.Negate)
+		specs[n].SetRequired(s.Required)
+		specs[n].SetFields(fields)
+	}
+
+	customFormat := sonarr.NewCustomFormatResource()
+	customFormat.SetName(export.Name)
+	customFormat.SetIncludeCustomFormatWhenRenaming(export.IncludeCustomFormatWhenRenaming)
+	customFormat.SetSpecifications(specs)
+
+	return customFormat, nil
+}
+
+// fields returns the specification fields, whether they are exported as object or as list.
+func (s customFormatExportSpecification) fields() ([]sonarr.Field, error) {
+	if len(s.Fields) == 0 || string(s.Fields) == "null" {
+		return nil, nil
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(s.Fields, &values); err == nil {
+		names := make([]string, 0, len(values))
+		for name := range values {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		fields := make([]sonarr.Field, len(names))
+		for i, name := range names {
+			fields[i] = *sonarr.NewField()
+			fields[i].SetName(name)
+			fields[i].SetValue(values[name])
+		}
+
+		return fields, nil
+	}
+
+	var fields []sonarr.Field
+	if err := json.Unmarshal(s.Fields, &fields); err != nil {
+		return nil, errCustomFormatJSONFields
+	}
+
+	return fields, nil
+}
+
+// exportCustomFormatJSON encodes the custom format in the same shape of the Sonarr UI export.
+func exportCustomFormatJSON(customFormat *sonarr.CustomFormatResource) (string, error) {
+	export := customFormatExport{
+		Name:                            customFormat.GetName(),
+		IncludeCustomFormatWhenRenaming: customFormat.GetIncludeCustomFormatWhenRenaming(),
+		Specifications:                  make([]customFormatExportSpecification, len(customFormat.GetSpecifications())),
+	}
+
+	for n, s := range customFormat.GetSpecifications() {
+		values := make(map[string]interface{}, len(s.GetFields()))
+		for _, f := range s.GetFields() {
+			values[f.GetName()] = f.GetValue()
+		}
+
+		fields, err := json.Marshal(values)
+		if err != nil {
+			return "", err
+		}
+
+		export.Specifications[n] = customFormatExportSpecification{
+			Name:           s.GetName(),
+			Implementation: s.GetImplementation(),
+			Negate:         s.GetNegate(),
+			Required:       s.GetRequired(),
+			Fields:         fields,
+		}
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+
+	return string(data), err
+}
@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccCustomFormatJSONDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid JSON
+			{
+				Config:      testAccCustomFormatJSONDataSourceInvalidConfig,
+				ExpectError: regexp.MustCompile("Unable to parse custom_format_json"),
+			},
+			// Read testing
+			{
+				Config: testAccCustomFormatJSONDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_custom_format_json.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_custom_format_json.test", "name", "TestWithDSJSON"),
+					resource.TestCheckResourceAttr("sonarr_custom_format.test", "include_custom_format_when_renaming", "true"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_custom_format.test", "specifications.*", map[string]string{"implementation": "SourceSpecification", "value": "7"}),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_custom_format.test", "specifications.*", map[string]string{"implementation": "SizeSpecification", "min": "0", "max": "100"})),
+			},
+		},
+	})
+}
+
+const testAccCustomFormatJSONDataSourceInvalidConfig = `
+data  "sonarr_custom_format_json" "test" {
+	json = jsonencode({
+		includeCustomFormatWhenRenaming = true
+		specifications = []
+	})
+}`
+
+const testAccCustomFormatJSONDataSourceConfig = `
+data  "sonarr_custom_format_json" "test" {
+	json = jsonencode({
+		name = "TestWithDSJSON"
+		includeCustomFormatWhenRenaming = true
+		specifications = [
+			{
+				name = "WEBDL"
+				implementation = "SourceSpecification"
+				negate = false
+				required = true
+				fields = {
+					value = 7
+				}
+			},
+			{
+				name = "Size"
+				implementation = "SizeSpecification"
+				negate = false
+				required = false
+				fields = [
+					{ name = "min", value = 0 },
+					{ name = "max", value = 100 },
+				]
+			},
+		]
+	})
+}
+
+resource "sonarr_custom_format" "test" {
+	include_custom_format_when_renaming = data.sonarr_custom_format_json.test.include_custom_format_when_renaming
+	name = data.sonarr_custom_format_json.test.name
+
+	specifications = data.sonarr_custom_format_json.test.specifications
+}`
+
+func TestParseCustomFormatJSON(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		json   string
+		fields map[string]interface{}
+		err    bool
+	}{
+		"exported": {
+			json:   `{"trash_id":"abc","name":"HDR","includeCustomFormatWhenRenaming":true,"specifications":[{"name":"2160p","implementation":"ResolutionSpecification","negate":false,"required":true,"fields":{"value":2160}}]}`,
+			fields: map[string]interface{}{"value": float64(2160)},
+		},
+		"api": {
+			json:   `{"name":"HDR","includeCustomFormatWhenRenaming":true,"specifications":[{"name":"2160p","implementation":"ResolutionSpecification","negate":false,"required":true,"fields":[{"name":"value","value":2160}]}]}`,
+			fields: map[string]interface{}{"value": float64(2160)},
+		},
+		"size": {
+			json:   `{"name":"HDR","includeCustomFormatWhenRenaming":true,"specifications":[{"name":"Size","implementation":"SizeSpecification","negate":false,"required":true,"fields":{"max":10,"min":1}}]}`,
+			fields: map[string]interface{}{"min": float64(1), "max": float64(10)},
+		},
+		"missing name": {
+			json: `{"includeCustomFormatWhenRenaming":true,"specifications":[]}`,
+			err:  true,
+		},
+		"invalid fields": {
+			json: `{"name":"HDR","specifications":[{"name":"2160p","implementation":"ResolutionSpecification","fields":"value"}]}`,
+			err:  true,
+		},
+		"invalid json": {
+			json: `{"name":`,
+			err:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			customFormat, err := parseCustomFormatJSON(test.json)
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "HDR", customFormat.GetName())
+			assert.True(t, customFormat.GetIncludeCustomFormatWhenRenaming())
+			assert.Len(t, customFormat.GetSpecifications(), 1)
+
+			spec := customFormat.GetSpecifications()[0]
+			assert.True(t, spec.GetRequired())
+
+			fields := make(map[string]interface{})
+			for _, f := range spec.GetFields() {
+				fields[f.GetName()] = f.GetValue()
+			}
+
+			assert.Equal(t, test.fields, fields)
+		})
+	}
+}
+
+func TestExportCustomFormatJSON(t *testing.T) {
+	t.Parallel()
+
+	exported := `{"name":"HDR","includeCustomFormatWhenRenaming":true,"specifications":[{"name":"2160p","implementation":"ResolutionSpecification","negate":true,"required":false,"fields":{"value":2160}},{"name":"Size","implementation":"SizeSpecification","negate":false,"required":true,"fields":{"max":10,"min":1}}]}`
+
+	customFormat, err := parseCustomFormatJSON(exported)
+	assert.NoError(t, err)
+
+	customFormat.SetId(10)
+
+	data, err := exportCustomFormatJSON(customFormat)
+	assert.NoError(t, err)
+	assert.JSONEq(t, exported, data)
+
+	// the export can be parsed back
+	parsed, err := parseCustomFormatJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, customFormat.GetSpecifications(), parsed.GetSpecifications())
+}
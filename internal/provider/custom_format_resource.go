@@ -39,6 +39,7 @@ type CustomFormatResource struct {
 type CustomFormat struct {
 	Specifications                  types.Set    `tfsdk:"specifications"`
 	Name                            types.String `tfsdk:"name"`
+	ExportJSON                      types.String `tfsdk:"export_json"`
 	ID                              types.Int64  `tfsdk:"id"`
 	IncludeCustomFormatWhenRenaming types.Bool   `tfsdk:"include_custom_format_when_renaming"`
 }
@@ -49,6 +50,7 @@ func (c CustomFormat) getType() attr.Type {
 			"include_custom_format_when_renaming": types.BoolType,
 			"id":                                  types.Int64Type,
 			"name":                                types.StringType,
+			"export_json":                         types.StringType,
 			"specifications":                      types.SetType{}.WithElementType(CustomFormatCondition{}.getType()),
 		})
 }
@@ -77,6 +79,10 @@ func (r *CustomFormatResource) Schema(_ context.Context, _ resource.SchemaReques
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"export_json": schema.StringAttribute{
+				MarkdownDescription: "Custom Format JSON, in the same shape of the Sonarr UI export. It can be parsed back by `sonarr_custom_format_json`.",
+				Computed:            true,
+			},
 			"specifications": schema.SetNestedAttribute{
 				MarkdownDescription: "Specifications.",
 				Required:            true,
@@ -245,7 +251,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *CustomFormatResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, customFormatResourceName, findCustomFormatIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+customFormatResourceName+": "+req.ID)
 }
 
@@ -263,6 +269,13 @@ func (c *CustomFormat) write(ctx context.Context, customFormat *sonarr.CustomFor
 
 	c.Specifications, tempDiag = types.SetValueFrom(ctx, CustomFormatResource{}.getSpecificationSchema().Type(), specs)
 	diags.Append(tempDiag...)
+
+	exportJSON, err := exportCustomFormatJSON(customFormat)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to export "+customFormatResourceName+", got error: "+err.Error())
+	}
+
+	c.ExportJSON = types.StringValue(exportJSON)
 }
 
 func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.CustomFormatResource {
@@ -282,3 +295,12 @@ func (c *CustomFormat) read(ctx context.Context, diags *diag.Diagnostics) *sonar
 
 	return format
 }
+
+// findCustomFormatIDs returns a function listing the IDs of the custom formats with the given name.
+func findCustomFormatIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.CustomFormatResource, error) {
+		response, _, err := client.CustomFormatAPI.ListCustomFormat(auth).Execute()
+
+		return response, err
+	}, (*sonarr.CustomFormatResource).GetName, (*sonarr.CustomFormatResource).GetId, nil)
+}
@@ -25,6 +25,7 @@ func TestAccCustomFormatResource(t *testing.T) {
 				Config: testAccCustomFormatResourceConfig("resourceTest", "false"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_custom_format.test", "include_custom_format_when_renaming", "false"),
+					resource.TestMatchResourceAttr("sonarr_custom_format.test", "export_json", regexp.MustCompile(`"includeCustomFormatWhenRenaming": false`)),
 					resource.TestCheckResourceAttrSet("sonarr_custom_format.test", "id"),
 				),
 			},
@@ -0,0 +1,251 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+)
+
+// defaultDelayProfileID is the delay profile which cannot hold any tag.
+const defaultDelayProfileID = 1
+
+var errDefaultTagExplicit = errors.New("provider default tags must not be set explicitly")
+
+var (
+	tagPathRegexp          = regexp.MustCompile(`/api/v3/tag(/.*)?$`)
+	delayProfilePathRegexp = regexp.MustCompile(`/api/v3/delayprofile(/.*)?$`)
+)
+
+// defaultTagsTransport merges the provider default tags into every write request
+// and strips them from every response, so that they never show up in diffs.
+type defaultTagsTransport struct {
+	next   http.RoundTripper
+	auth   context.Context
+	client *sonarr.APIClient
+	labels []string
+	ids    []int32
+	mu     sync.Mutex
+}
+
+// newDefaultTagsTransport wraps the given transport with the default tags logic.
+func newDefaultTagsTransport(next http.RoundTripper, config *sonarr.Configuration, auth context.Context, labels []string) *defaultTagsTransport {
+	// tag calls are never altered by the transport, hence the client can be shared.
+	tagConfig := *config
+	tagConfig.HTTPClient = &http.Client{Transport: next}
+
+	return &defaultTagsTransport{
+		next:   next,
+		auth:   auth,
+		client: sonarr.NewAPIClient(&tagConfig),
+		labels: labels,
+	}
+}
+
+func (t *defaultTagsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if tagPathRegexp.MatchString(req.URL.Path) {
+		return t.next.RoundTrip(req)
+	}
+
+	write := req.Method == http.MethodPost || req.Method == http.MethodPut
+
+	ids, err := t.resolve(write)
+	if err != nil {
+		return nil, err
+	}
+
+	if write && req.Body != nil {
+		if err := t.mergeRequest(req, ids); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || len(ids) == 0 || !strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	body = stripTags(body, ids)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	resp.ContentLength = int64(len(body))
+	resp.Header.Del("Content-Length")
+
+	return resp, nil
+}
+
+// resolve returns the IDs of the default tags, creating the missing ones if needed.
+func (t *defaultTagsTransport) resolve(create bool) ([]int32, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if len(t.ids) == len(t.labels) {
+		return t.ids, nil
+	}
+
+	tags, _, err := t.client.TagAPI.ListTag(t.auth).Execute()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]int32, 0, len(t.labels))
+
+	for _, label := range t.labels {
+		index := slices.IndexFunc(tags, func(tag sonarr.TagResource) bool { return strings.EqualFold(tag.GetLabel(), label) })
+		if index >= 0 {
+			ids = append(ids, tags[index].GetId())
+
+			continue
+		}
+
+		if !create {
+			continue
+		}
+
+		request := sonarr.NewTagResource()
+		request.SetLabel(label)
+
+		tag, _, err := t.client.TagAPI.CreateTag(t.auth).TagResource(*request).Execute()
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, tag.GetId())
+	}
+
+	// cache only a complete resolution, so that missing tags are created on the first write.
+	if len(ids) == len(t.labels) {
+		t.ids = ids
+	}
+
+	return ids, nil
+}
+
+// mergeRequest adds the default tags to the request body.
+func (t *defaultTagsTransport) mergeRequest(req *http.Request, ids []int32) error {
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+
+	if err != nil {
+		return err
+	}
+
+	body, err = mergeTags(body, ids, delayProfilePathRegexp.MatchString(req.URL.Path))
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+
+	return nil
+}
+
+// mergeTags adds the given tags to a JSON object exposing a tags list.
+// Default tags set explicitly are rejected, since they would be stripped back on read.
+func mergeTags(body []byte, ids []int32, delayProfile bool) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body, &object); err != nil {
+		return body, nil
+	}
+
+	rawTags, ok := object["tags"]
+	if !ok {
+		return body, nil
+	}
+
+	if delayProfile && string(object["id"]) == strconv.Itoa(defaultDelayProfileID) {
+		return body, nil
+	}
+
+	var tags []int32
+	if err := json.Unmarshal(rawTags, &tags); err != nil {
+		return body, nil
+	}
+
+	for _, id := range ids {
+		if slices.Contains(tags, id) {
+			return nil, fmt.Errorf("%w: tag ID %d", errDefaultTagExplicit, id)
+		}
+
+		tags = append(tags, id)
+	}
+
+	return marshalTags(body, object, tags), nil
+}
+
+// stripTags removes the given tags from a JSON object or list of objects exposing a tags list.
+func stripTags(body []byte, ids []int32) []byte {
+	var list []json.RawMessage
+	if err := json.Unmarshal(body, &list); err == nil {
+		for i := range list {
+			list[i] = stripTags(list[i], ids)
+		}
+
+		output, err := json.Marshal(list)
+		if err != nil {
+			return body
+		}
+
+		return output
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(body, &object); err != nil {
+		return body
+	}
+
+	rawTags, ok := object["tags"]
+	if !ok {
+		return body
+	}
+
+	var tags []int32
+	if err := json.Unmarshal(rawTags, &tags); err != nil {
+		return body
+	}
+
+	tags = slices.DeleteFunc(tags, func(id int32) bool { return slices.Contains(ids, id) })
+
+	return marshalTags(body, object, tags)
+}
+
+// marshalTags replaces the tags list into the given object.
+func marshalTags(body []byte, object map[string]json.RawMessage, tags []int32) []byte {
+	if tags == nil {
+		tags = []int32{}
+	}
+
+	rawTags, err := json.Marshal(tags)
+	if err != nil {
+		return body
+	}
+
+	object["tags"] = rawTags
+
+	output, err := json.Marshal(object)
+	if err != nil {
+		return body
+	}
+
+	return output
+}
@@ -0,0 +1,151 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body         string
+		expected     string
+		delayProfile bool
+		err          bool
+	}{
+		"empty": {
+			body:     `{"id":2,"tags":[]}`,
+			expected: `{"id":2,"tags":[5,6]}`,
+		},
+		"existing": {
+			body:     `{"id":2,"tags":[1,2]}`,
+			expected: `{"id":2,"tags":[1,2,5,6]}`,
+		},
+		"explicit_default": {
+			body: `{"id":2,"tags":[1,5]}`,
+			err:  true,
+		},
+		"no_tags": {
+			body:     `{"id":2,"label":"test"}`,
+			expected: `{"id":2,"label":"test"}`,
+		},
+		"default_delay_profile": {
+			body:         `{"id":1,"tags":[]}`,
+			delayProfile: true,
+			expected:     `{"id":1,"tags":[]}`,
+		},
+		"delay_profile": {
+			body:         `{"id":3,"tags":[]}`,
+			delayProfile: true,
+			expected:     `{"id":3,"tags":[5,6]}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body, err := mergeTags([]byte(test.body), []int32{5, 6}, test.delayProfile)
+			if test.err {
+				assert.ErrorIs(t, err, errDefaultTagExplicit)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.JSONEq(t, test.expected, string(body))
+		})
+	}
+}
+
+func TestStripTags(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		body     string
+		expected string
+	}{
+		"object": {
+			body:     `{"id":2,"tags":[1,5,6]}`,
+			expected: `{"id":2,"tags":[1]}`,
+		},
+		"list": {
+			body:     `[{"id":2,"tags":[5]},{"id":3,"tags":[2,6]}]`,
+			expected: `[{"id":2,"tags":[]},{"id":3,"tags":[2]}]`,
+		},
+		"no_tags": {
+			body:     `{"id":2,"label":"test"}`,
+			expected: `{"id":2,"label":"test"}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.JSONEq(t, test.expected, string(stripTags([]byte(test.body), []int32{5, 6})))
+		})
+	}
+}
+
+func TestDefaultTagsTransport(t *testing.T) {
+	t.Parallel()
+
+	var received string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/api/v3/tag" && r.Method == http.MethodGet:
+			_, _ = w.Write([]byte(`[{"id":1,"label":"other"}]`))
+		case r.URL.Path == "/api/v3/tag" && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":7,"label":"terraform-managed"}`))
+		default:
+			body, _ := io.ReadAll(r.Body)
+			received = string(body)
+			_, _ = w.Write(body)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	config := sonarr.NewConfiguration()
+	auth := context.WithValue(context.Background(), sonarr.ContextServerVariables, map[string]string{
+		"protocol": serverURL.Scheme,
+		"hostpath": serverURL.Host,
+	})
+	config.HTTPClient = &http.Client{
+		Transport: newDefaultTagsTransport(http.DefaultTransport, config, auth, []string{"terraform-managed"}),
+	}
+
+	request := sonarr.NewDelayProfileResource()
+	request.SetId(2)
+	request.SetTags([]int32{1})
+
+	response, _, err := sonarr.NewAPIClient(config).DelayProfileAPI.CreateDelayProfile(auth).DelayProfileResource(*request).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{1}, response.GetTags())
+
+	var sent map[string]json.RawMessage
+
+	assert.NoError(t, json.NewDecoder(strings.NewReader(received)).Decode(&sent))
+	assert.JSONEq(t, `[1,7]`, string(sent["tags"]))
+
+	// a default tag set explicitly would be stripped back on read
+	request.SetTags([]int32{1, 7})
+
+	_, _, err = sonarr.NewAPIClient(config).DelayProfileAPI.CreateDelayProfile(auth).DelayProfileResource(*request).Execute()
+	assert.ErrorIs(t, err, errDefaultTagExplicit)
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +37,10 @@ func NewDownloadClientAria2Resource() resource.Resource {
 
 // DownloadClientAria2Resource defines the download client implementation.
 type DownloadClientAria2Resource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientAria2 describes the download client data model.
@@ -49,10 +53,12 @@ type DownloadClientAria2 struct {
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientAria2) toDownloadClient() *DownloadClient {
@@ -117,6 +123,9 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -128,6 +137,8 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -145,11 +156,17 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"rpc_path": schema.StringAttribute{
 				MarkdownDescription: "RPC path.",
@@ -167,9 +184,11 @@ func (r *DownloadClientAria2Resource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientAria2Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -186,7 +205,11 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
 
@@ -236,6 +259,10 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientAria2ResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
@@ -271,7 +298,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientAria2Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientAria2ResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientAria2Implementation, downloadClientAria2Protocol))
 	tflog.Trace(ctx, "imported "+downloadClientAria2ResourceName+": "+req.ID)
 }
 
@@ -36,6 +36,18 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nSingle [Download Client](../resources/download_client).",
 		Attributes: map[string]schema.Attribute{
+			"secrets_revision": schema.Int64Attribute{
+				MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+				Computed:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Computed:            true,
@@ -122,12 +134,40 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
 				Computed:            true,
 			},
+			"recent_priority": schema.Int64Attribute{
+				MarkdownDescription: "Recent priority. `0` Last, `1` First.",
+				Computed:            true,
+			},
+			"older_priority": schema.Int64Attribute{
+				MarkdownDescription: "Older priority. `0` Last, `1` First.",
+				Computed:            true,
+			},
 			"initial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
 				Computed:            true,
 			},
 			"intial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+				MarkdownDescription: "Misspelled alias of `initial_state`.",
+				Computed:            true,
+			},
+			"recent_tv_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Recent TV priority.",
+				Computed:            true,
+			},
+			"older_tv_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Older TV priority.",
+				Computed:            true,
+			},
+			"recent_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Recent priority.",
+				Computed:            true,
+			},
+			"older_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Older priority.",
+				Computed:            true,
+			},
+			"initial_state_name": schema.StringAttribute{
+				MarkdownDescription: "Initial state.",
 				Computed:            true,
 			},
 			"host": schema.StringAttribute{
@@ -147,6 +187,19 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Base URL.",
 				Computed:            true,
 			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "API URL.",
+				Computed:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "App ID.",
+				Computed:            true,
+			},
+			"app_token": schema.StringAttribute{
+				MarkdownDescription: "App token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"secret_token": schema.StringAttribute{
 				MarkdownDescription: "Secret token.",
 				Computed:            true,
@@ -177,6 +230,10 @@ func (d *DownloadClientDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Destination.",
 				Computed:            true,
 			},
+			"destination_directory": schema.StringAttribute{
+				MarkdownDescription: "Destination directory.",
+				Computed:            true,
+			},
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
 				Computed:            true,
@@ -30,6 +30,7 @@ func TestAccDownloadClientDataSource(t *testing.T) {
 				Config: testAccDownloadClientResourceConfig("dataTest", "true") + testAccDownloadClientDataSourceConfig("sonarr_download_client.test.name"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.sonarr_download_client.test", "id"),
+					resource.TestCheckResourceAttrSet("data.sonarr_download_client.test", "extra_fields"),
 					resource.TestCheckResourceAttr("data.sonarr_download_client.test", "protocol", "torrent")),
 			},
 		},
@@ -37,8 +37,10 @@ func NewDownloadClientDelugeResource() resource.Resource {
 
 // DownloadClientDelugeResource defines the download client implementation.
 type DownloadClientDelugeResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientDeluge describes the download client data model.
@@ -50,16 +52,20 @@ type DownloadClientDeluge struct {
 	Password                 types.String `tfsdk:"password"`
 	TvCategory               types.String `tfsdk:"tv_category"`
 	TvImportedCategory       types.String `tfsdk:"tv_imported_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	AddPaused                types.Bool   `tfsdk:"add_paused"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
@@ -72,6 +78,8 @@ func (d DownloadClientDeluge) toDownloadClient() *DownloadClient {
 		TvCategory:               d.TvCategory,
 		TvImportedCategory:       d.TvImportedCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
@@ -96,6 +104,8 @@ func (d *DownloadClientDeluge) fromDownloadClient(client *DownloadClient) {
 	d.TvCategory = client.TvCategory
 	d.TvImportedCategory = client.TvImportedCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
@@ -134,6 +144,9 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -145,6 +158,8 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -167,27 +182,37 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientTorrentPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientTorrentPriorities),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -214,9 +239,11 @@ func (r *DownloadClientDelugeResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DownloadClientDelugeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -233,7 +260,11 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
 
@@ -283,6 +314,10 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientDelugeResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
@@ -318,7 +353,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientDelugeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientDelugeResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientDelugeImplementation, downloadClientDelugeProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientDelugeResourceName+": "+req.ID)
 }
 
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +37,10 @@ func NewDownloadClientFloodResource() resource.Resource {
 
 // DownloadClientFloodResource defines the download client implementation.
 type DownloadClientFloodResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientFlood describes the download client data model.
@@ -54,11 +58,13 @@ type DownloadClientFlood struct {
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	StartOnAdd               types.Bool   `tfsdk:"start_on_add"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientFlood) toDownloadClient() *DownloadClient {
@@ -135,6 +141,9 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -146,6 +155,8 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -168,11 +179,17 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -218,9 +235,11 @@ func (r *DownloadClientFloodResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *DownloadClientFloodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -237,7 +256,11 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
 
@@ -287,6 +310,10 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientFloodResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
@@ -322,7 +349,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *DownloadClientFloodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientFloodResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientFloodImplementation, downloadClientFloodProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientFloodResourceName+": "+req.ID)
 }
 
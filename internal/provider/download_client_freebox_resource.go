@@ -0,0 +1,366 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	downloadClientFreeboxResourceName   = "download_client_freebox"
+	downloadClientFreeboxImplementation = "TorrentFreeboxDownload"
+	downloadClientFreeboxConfigContract = "FreeboxDownloadSettings"
+	downloadClientFreeboxProtocol       = "torrent"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &DownloadClientFreeboxResource{}
+	_ resource.ResourceWithImportState = &DownloadClientFreeboxResource{}
+)
+
+func NewDownloadClientFreeboxResource() resource.Resource {
+	return &DownloadClientFreeboxResource{}
+}
+
+// DownloadClientFreeboxResource defines the download client implementation.
+type DownloadClientFreeboxResource struct {
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
+}
+
+// DownloadClientFreebox describes the download client data model.
+type DownloadClientFreebox struct {
+	Tags                     types.Set    `tfsdk:"tags"`
+	Name                     types.String `tfsdk:"name"`
+	Host                     types.String `tfsdk:"host"`
+	APIURL                   types.String `tfsdk:"api_url"`
+	AppID                    types.String `tfsdk:"app_id"`
+	AppToken                 types.String `tfsdk:"app_token"`
+	DestinationDirectory     types.String `tfsdk:"destination_directory"`
+	Category                 types.String `tfsdk:"category"`
+	RecentPriority           types.String `tfsdk:"recent_priority"`
+	OlderPriority            types.String `tfsdk:"older_priority"`
+	Priority                 types.Int64  `tfsdk:"priority"`
+	Port                     types.Int64  `tfsdk:"port"`
+	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
+	AddPaused                types.Bool   `tfsdk:"add_paused"`
+	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
+	Enable                   types.Bool   `tfsdk:"enable"`
+	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
+	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (d DownloadClientFreebox) toDownloadClient() *DownloadClient {
+	return &DownloadClient{
+		Tags:                     d.Tags,
+		Name:                     d.Name,
+		Host:                     d.Host,
+		APIURL:                   d.APIURL,
+		AppID:                    d.AppID,
+		AppToken:                 d.AppToken,
+		DestinationDirectory:     d.DestinationDirectory,
+		Category:                 d.Category,
+		RecentPriorityName:       d.RecentPriority,
+		OlderPriorityName:        d.OlderPriority,
+		Priority:                 d.Priority,
+		Port:                     d.Port,
+		ID:                       d.ID,
+		AddPaused:                d.AddPaused,
+		UseSsl:                   d.UseSsl,
+		Enable:                   d.Enable,
+		RemoveFailedDownloads:    d.RemoveFailedDownloads,
+		RemoveCompletedDownloads: d.RemoveCompletedDownloads,
+		Implementation:           types.StringValue(downloadClientFreeboxImplementation),
+		ConfigContract:           types.StringValue(downloadClientFreeboxConfigContract),
+		Protocol:                 types.StringValue(downloadClientFreeboxProtocol),
+	}
+}
+
+func (d *DownloadClientFreebox) fromDownloadClient(client *DownloadClient) {
+	d.Tags = client.Tags
+	d.Name = client.Name
+	d.Host = client.Host
+	d.APIURL = client.APIURL
+	d.AppID = client.AppID
+	d.AppToken = client.AppToken
+	d.DestinationDirectory = client.DestinationDirectory
+	d.Category = client.Category
+	d.RecentPriority = client.RecentPriorityName
+	d.OlderPriority = client.OlderPriorityName
+	d.Priority = client.Priority
+	d.Port = client.Port
+	d.ID = client.ID
+	d.AddPaused = client.AddPaused
+	d.UseSsl = client.UseSsl
+	d.Enable = client.Enable
+	d.RemoveFailedDownloads = client.RemoveFailedDownloads
+	d.RemoveCompletedDownloads = client.RemoveCompletedDownloads
+}
+
+func (r *DownloadClientFreeboxResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientFreeboxResourceName
+}
+
+func (r *DownloadClientFreeboxResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client Freebox resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [Freebox](https://wiki.servarr.com/sonarr/supported#torrentfreeboxdownload).",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"remove_completed_downloads": schema.BoolAttribute{
+				MarkdownDescription: "Remove completed downloads flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"remove_failed_downloads": schema.BoolAttribute{
+				MarkdownDescription: "Remove failed downloads flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"priority": schema.Int64Attribute{
+				MarkdownDescription: "Priority.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Download Client name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Download Client ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"add_paused": schema.BoolAttribute{
+				MarkdownDescription: "Add paused flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"use_ssl": schema.BoolAttribute{
+				MarkdownDescription: "Use SSL flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Port.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
+			},
+			"recent_priority": schema.StringAttribute{
+				MarkdownDescription: "Recent priority. Allowed values: " + downloadClientTorrentPriorities.description() + ".",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(downloadClientTorrentPriorities.names()...),
+				},
+			},
+			"older_priority": schema.StringAttribute{
+				MarkdownDescription: "Older priority. Allowed values: " + downloadClientTorrentPriorities.description() + ".",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(downloadClientTorrentPriorities.names()...),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "host.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
+			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "API URL, the Freebox API base URL with its version.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "App ID given when creating access to the Freebox API.",
+				Required:            true,
+			},
+			"app_token": schema.StringAttribute{
+				MarkdownDescription: "App token retrieved when creating access to the Freebox API.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"destination_directory": schema.StringAttribute{
+				MarkdownDescription: "Destination directory, the Freebox default location when empty.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"category": schema.StringAttribute{
+				MarkdownDescription: "Category.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DownloadClientFreeboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
+	}
+}
+
+func (r *DownloadClientFreeboxResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var client *DownloadClientFreebox
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new DownloadClientFreebox
+	request := client.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientFreeboxResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFreeboxResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+}
+
+func (r *DownloadClientFreeboxResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var client DownloadClientFreebox
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &client)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get DownloadClientFreebox current value
+	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFreeboxResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+}
+
+func (r *DownloadClientFreeboxResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var client *DownloadClientFreebox
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &client)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update DownloadClientFreebox
+	request := client.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientFreeboxResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFreeboxResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	client.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &client)...)
+}
+
+func (r *DownloadClientFreeboxResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete DownloadClientFreebox current value
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFreeboxResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+downloadClientFreeboxResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *DownloadClientFreeboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientFreeboxResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientFreeboxImplementation, downloadClientFreeboxProtocol))
+	tflog.Trace(ctx, "imported "+downloadClientFreeboxResourceName+": "+req.ID)
+}
+
+func (d *DownloadClientFreebox) write(ctx context.Context, downloadClient *sonarr.DownloadClientResource, diags *diag.Diagnostics) {
+	genericDownloadClient := d.toDownloadClient()
+	genericDownloadClient.write(ctx, downloadClient, diags)
+	d.fromDownloadClient(genericDownloadClient)
+}
+
+func (d *DownloadClientFreebox) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.DownloadClientResource {
+	return d.toDownloadClient().read(ctx, diags)
+}
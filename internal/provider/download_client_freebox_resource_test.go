@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientFreeboxResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccDownloadClientFreeboxResourceConfig("resourceFreeboxTest", "freebox") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccDownloadClientFreeboxResourceConfig("resourceFreeboxTest", "freebox"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_download_client_freebox.test", "host", "freebox"),
+					resource.TestCheckResourceAttr("sonarr_download_client_freebox.test", "recent_priority", "first"),
+					resource.TestCheckResourceAttrSet("sonarr_download_client_freebox.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccDownloadClientFreeboxResourceConfig("resourceFreeboxTest", "freebox") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccDownloadClientFreeboxResourceConfig("resourceFreeboxTest", "freebox-host"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_download_client_freebox.test", "host", "freebox-host"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_download_client_freebox.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"app_token"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccDownloadClientFreeboxResourceConfig(name, host string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_download_client_freebox" "test" {
+		enable = false
+		priority = 1
+		name = "%s"
+		host = "%s"
+		port = 443
+		use_ssl = true
+		app_id = "fr.freebox.sonarr"
+		app_token = "token"
+		category = "sonarr-tv"
+		recent_priority = "first"
+	}`, name, host)
+}
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +37,10 @@ func NewDownloadClientHadoukenResource() resource.Resource {
 
 // DownloadClientHadoukenResource defines the download client implementation.
 type DownloadClientHadoukenResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientHadouken describes the download client data model.
@@ -51,10 +55,12 @@ type DownloadClientHadouken struct {
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientHadouken) toDownloadClient() *DownloadClient {
@@ -123,6 +129,9 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -134,6 +143,8 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -151,11 +162,17 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -181,9 +198,11 @@ func (r *DownloadClientHadoukenResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *DownloadClientHadoukenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -200,7 +219,11 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
 
@@ -250,6 +273,10 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientHadoukenResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
@@ -285,7 +312,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientHadoukenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientHadoukenResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientHadoukenImplementation, downloadClientHadoukenProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientHadoukenResourceName+": "+req.ID)
 }
 
@@ -25,6 +25,15 @@ const (
 	downloadClientNzbgetProtocol       = "usenet"
 )
 
+var downloadClientNzbgetPriorities = intEnum{
+	-100: "very_low",
+	-50:  "low",
+	0:    "normal",
+	50:   "high",
+	100:  "very_high",
+	900:  "force",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &DownloadClientNzbgetResource{}
@@ -37,8 +46,10 @@ func NewDownloadClientNzbgetResource() resource.Resource {
 
 // DownloadClientNzbgetResource defines the download client implementation.
 type DownloadClientNzbgetResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientNzbget describes the download client data model.
@@ -50,16 +61,20 @@ type DownloadClientNzbget struct {
 	Username                 types.String `tfsdk:"username"`
 	Password                 types.String `tfsdk:"password"`
 	TvCategory               types.String `tfsdk:"tv_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	AddPaused                types.Bool   `tfsdk:"add_paused"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
@@ -72,6 +87,8 @@ func (d DownloadClientNzbget) toDownloadClient() *DownloadClient {
 		Password:                 d.Password,
 		TvCategory:               d.TvCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
@@ -96,6 +113,8 @@ func (d *DownloadClientNzbget) fromDownloadClient(client *DownloadClient) {
 	d.Password = client.Password
 	d.TvCategory = client.TvCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
@@ -134,6 +153,9 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -145,6 +167,8 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -167,27 +191,37 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `-100` VeryLow, `-50` Low, `0` Normal, `50` High, `100` VeryHigh, `900` Force.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(-100, -50, 0, 50, 100, 900),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientNzbgetPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `-100` VeryLow, `-50` Low, `0` Normal, `50` High, `100` VeryHigh, `900` Force.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(-100, -50, 0, 50, 100, 900),
 				},
 			},
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientNzbgetPriorities),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -215,9 +249,11 @@ func (r *DownloadClientNzbgetResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DownloadClientNzbgetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -234,7 +270,11 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
 
@@ -284,6 +324,10 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientNzbgetResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
@@ -319,7 +363,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 }
 
 func (r *DownloadClientNzbgetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientNzbgetResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientNzbgetImplementation, downloadClientNzbgetProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientNzbgetResourceName+": "+req.ID)
 }
 
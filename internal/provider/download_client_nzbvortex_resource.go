@@ -25,6 +25,12 @@ const (
 	downloadClientNzbvortexProtocol       = "usenet"
 )
 
+var downloadClientNzbvortexPriorities = intEnum{
+	-1: "low",
+	0:  "normal",
+	1:  "high",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &DownloadClientNzbvortexResource{}
@@ -37,8 +43,10 @@ func NewDownloadClientNzbvortexResource() resource.Resource {
 
 // DownloadClientNzbvortexResource defines the download client implementation.
 type DownloadClientNzbvortexResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientNzbvortex describes the download client data model.
@@ -49,14 +57,18 @@ type DownloadClientNzbvortex struct {
 	URLBase                  types.String `tfsdk:"url_base"`
 	APIKey                   types.String `tfsdk:"api_key"`
 	TvCategory               types.String `tfsdk:"tv_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
@@ -68,6 +80,8 @@ func (d DownloadClientNzbvortex) toDownloadClient() *DownloadClient {
 		APIKey:                   d.APIKey,
 		TvCategory:               d.TvCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
@@ -89,6 +103,8 @@ func (d *DownloadClientNzbvortex) fromDownloadClient(client *DownloadClient) {
 	d.APIKey = client.APIKey
 	d.TvCategory = client.TvCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
@@ -125,6 +141,9 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -136,6 +155,8 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -148,27 +169,37 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `-1` Low, `0` Normal, `1` High.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(-1, 0, 1),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientNzbvortexPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `-1` Low, `0` Normal, `1` High.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(-1, 0, 1),
 				},
 			},
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientNzbvortexPriorities),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -190,9 +221,11 @@ func (r *DownloadClientNzbvortexResource) Schema(_ context.Context, _ resource.S
 }
 
 func (r *DownloadClientNzbvortexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -209,7 +242,11 @@ func (r *DownloadClientNzbvortexResource) Create(ctx context.Context, req resour
 	// Create new DownloadClientNzbvortex
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientNzbvortexResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbvortexResourceName, err))
 
@@ -259,6 +296,10 @@ func (r *DownloadClientNzbvortexResource) Update(ctx context.Context, req resour
 	// Update DownloadClientNzbvortex
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientNzbvortexResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbvortexResourceName, err))
@@ -294,7 +335,7 @@ func (r *DownloadClientNzbvortexResource) Delete(ctx context.Context, req resour
 }
 
 func (r *DownloadClientNzbvortexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientNzbvortexResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientNzbvortexImplementation, downloadClientNzbvortexProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientNzbvortexResourceName+": "+req.ID)
 }
 
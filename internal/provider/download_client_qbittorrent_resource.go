@@ -25,7 +25,11 @@ const (
 	downloadClientQbittorrentProtocol       = "torrent"
 )
 
-var downloadClientQbittorrentInitialStates = []int64{0, 1, 2}
+var downloadClientQbittorrentInitialStates = intEnum{
+	0: "start",
+	1: "force_start",
+	2: "pause",
+}
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
@@ -39,8 +43,10 @@ func NewDownloadClientQbittorrentResource() resource.Resource {
 
 // DownloadClientQbittorrentResource defines the download client implementation.
 type DownloadClientQbittorrentResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientQbittorrent describes the download client data model.
@@ -53,18 +59,23 @@ type DownloadClientQbittorrent struct {
 	Username                 types.String `tfsdk:"username"`
 	Password                 types.String `tfsdk:"password"`
 	TvCategory               types.String `tfsdk:"tv_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
+	InitialStateName         types.String `tfsdk:"initial_state_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	InitialState             types.Int64  `tfsdk:"initial_state"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
 	FirstAndLast             types.Bool   `tfsdk:"first_and_last"`
 	SequentialOrder          types.Bool   `tfsdk:"sequential_order"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
@@ -77,12 +88,15 @@ func (d DownloadClientQbittorrent) toDownloadClient() *DownloadClient {
 		Password:                 d.Password,
 		TvCategory:               d.TvCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
 		ID:                       d.ID,
 		TvImportedCategory:       d.TvImportedCategory,
 		InitialState:             d.InitialState,
+		InitialStateName:         d.InitialStateName,
 		UseSsl:                   d.UseSsl,
 		Enable:                   d.Enable,
 		RemoveFailedDownloads:    d.RemoveFailedDownloads,
@@ -104,12 +118,15 @@ func (d *DownloadClientQbittorrent) fromDownloadClient(client *DownloadClient) {
 	d.Password = client.Password
 	d.TvCategory = client.TvCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
 	d.ID = client.ID
 	d.TvImportedCategory = client.TvImportedCategory
 	d.InitialState = client.InitialState
+	d.InitialStateName = client.InitialStateName
 	d.UseSsl = client.UseSsl
 	d.Enable = client.Enable
 	d.RemoveFailedDownloads = client.RemoveFailedDownloads
@@ -145,6 +162,9 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -156,6 +176,8 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -183,35 +205,47 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientTorrentPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientTorrentPriorities),
 			"initial_state": schema.Int64Attribute{
 				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("initial_state_name"),
 				Validators: []validator.Int64{
-					int64validator.OneOf(downloadClientQbittorrentInitialStates...),
+					int64validator.OneOf(0, 1, 2),
 				},
 			},
+			"initial_state_name": intEnumAttribute("Initial state.", "initial_state", downloadClientQbittorrentInitialStates),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -244,9 +278,11 @@ func (r *DownloadClientQbittorrentResource) Schema(_ context.Context, _ resource
 }
 
 func (r *DownloadClientQbittorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -263,7 +299,11 @@ func (r *DownloadClientQbittorrentResource) Create(ctx context.Context, req reso
 	// Create new DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientQbittorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientQbittorrentResourceName, err))
 
@@ -313,6 +353,10 @@ func (r *DownloadClientQbittorrentResource) Update(ctx context.Context, req reso
 	// Update DownloadClientQbittorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientQbittorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientQbittorrentResourceName, err))
@@ -348,7 +392,7 @@ func (r *DownloadClientQbittorrentResource) Delete(ctx context.Context, req reso
 }
 
 func (r *DownloadClientQbittorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientQbittorrentResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientQbittorrentImplementation, downloadClientQbittorrentProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientQbittorrentResourceName+": "+req.ID)
 }
 
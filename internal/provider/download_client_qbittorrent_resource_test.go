@@ -47,6 +47,13 @@ func TestAccDownloadClientQbittorrentResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// ImportState by name testing
+			{
+				ResourceName:      "sonarr_download_client_qbittorrent.test",
+				ImportState:       true,
+				ImportStateId:     "name=resourceQbittorrentTest",
+				ImportStateVerify: true,
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -17,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -24,14 +26,42 @@ const downloadClientResourceName = "download_client"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientResource{}
-	_ resource.ResourceWithImportState = &DownloadClientResource{}
+	_ resource.Resource                   = &DownloadClientResource{}
+	_ resource.ResourceWithImportState    = &DownloadClientResource{}
+	_ resource.ResourceWithValidateConfig = &DownloadClientResource{}
+	_ resource.ResourceWithUpgradeState   = &DownloadClientResource{}
+)
+
+const downloadClientIntialStateDeprecation = "Use `initial_state_name` instead, this misspelled attribute will be removed in a future major version."
+
+var (
+	// downloadClientTorrentPriorities are the queue priorities shared by most torrent clients.
+	downloadClientTorrentPriorities = intEnum{
+		0: "last",
+		1: "first",
+	}
+	downloadClientPriorities = map[string]intEnum{
+		downloadClientDelugeImplementation:       downloadClientTorrentPriorities,
+		downloadClientFreeboxImplementation:      downloadClientTorrentPriorities,
+		downloadClientNzbgetImplementation:       downloadClientNzbgetPriorities,
+		downloadClientNzbvortexImplementation:    downloadClientNzbvortexPriorities,
+		downloadClientQbittorrentImplementation:  downloadClientTorrentPriorities,
+		downloadClientRtorrentImplementation:     downloadClientRtorrentPriorities,
+		downloadClientSabnzbdImplementation:      downloadClientSabnzbdPriorities,
+		downloadClientTransmissionImplementation: downloadClientTorrentPriorities,
+		downloadClientUtorrentImplementation:     downloadClientTorrentPriorities,
+		downloadClientVuzeImplementation:         downloadClientTorrentPriorities,
+	}
+	downloadClientInitialStates = map[string]intEnum{
+		downloadClientQbittorrentImplementation: downloadClientQbittorrentInitialStates,
+		downloadClientUtorrentImplementation:    downloadClientUtorrentInitialStates,
+	}
 )
 
 var downloadClientFields = helpers.Fields{
 	Bools:                  []string{"addPaused", "useSsl", "startOnAdd", "sequentialOrder", "firstAndLast", "addStopped", "saveMagnetFiles", "readOnly"},
-	Ints:                   []string{"port", "recentTvPriority", "olderTvPriority", "initialState", "intialState"},
-	Strings:                []string{"host", "apiKey", "urlBase", "rpcPath", "secretToken", "password", "username", "tvCategory", "tvImportedCategory", "tvDirectory", "destination", "category", "nzbFolder", "strmFolder", "torrentFolder", "magnetFileExtension", "watchFolder"},
+	Ints:                   []string{"port", "recentTvPriority", "olderTvPriority", "recentPriority", "olderPriority", "initialState", "intialState"},
+	Strings:                []string{"host", "apiKey", "urlBase", "rpcPath", "secretToken", "password", "username", "tvCategory", "tvImportedCategory", "tvDirectory", "destination", "category", "nzbFolder", "strmFolder", "torrentFolder", "magnetFileExtension", "watchFolder", "apiUrl", "appId", "appToken", "destinationDirectory"},
 	StringSlices:           []string{"fieldTags", "postImportTags"},
 	StringSlicesExceptions: []string{"tags"},
 	IntSlices:              []string{"additionalTags"},
@@ -43,8 +73,10 @@ func NewDownloadClientResource() resource.Resource {
 
 // DownloadClientResource defines the download client implementation.
 type DownloadClientResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClient describes the download client data model.
@@ -53,6 +85,7 @@ type DownloadClient struct {
 	FieldTags                types.Set    `tfsdk:"field_tags"`
 	AdditionalTags           types.Set    `tfsdk:"additional_tags"`
 	PostImportTags           types.Set    `tfsdk:"post_import_tags"`
+	ExtraFields              types.String `tfsdk:"extra_fields"`
 	NzbFolder                types.String `tfsdk:"nzb_folder"`
 	Category                 types.String `tfsdk:"category"`
 	Implementation           types.String `tfsdk:"implementation"`
@@ -65,6 +98,10 @@ type DownloadClient struct {
 	Host                     types.String `tfsdk:"host"`
 	ConfigContract           types.String `tfsdk:"config_contract"`
 	Destination              types.String `tfsdk:"destination"`
+	DestinationDirectory     types.String `tfsdk:"destination_directory"`
+	APIURL                   types.String `tfsdk:"api_url"`
+	AppID                    types.String `tfsdk:"app_id"`
+	AppToken                 types.String `tfsdk:"app_token"`
 	TvDirectory              types.String `tfsdk:"tv_directory"`
 	Username                 types.String `tfsdk:"username"`
 	TvImportedCategory       types.String `tfsdk:"tv_imported_category"`
@@ -74,13 +111,21 @@ type DownloadClient struct {
 	RPCPath                  types.String `tfsdk:"rpc_path"`
 	URLBase                  types.String `tfsdk:"url_base"`
 	APIKey                   types.String `tfsdk:"api_key"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
+	InitialStateName         types.String `tfsdk:"initial_state_name"`
+	RecentPriorityName       types.String `tfsdk:"recent_priority_name"`
+	OlderPriorityName        types.String `tfsdk:"older_priority_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	IntialState              types.Int64  `tfsdk:"intial_state"`
 	InitialState             types.Int64  `tfsdk:"initial_state"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
+	RecentPriority           types.Int64  `tfsdk:"recent_priority"`
+	OlderPriority            types.Int64  `tfsdk:"older_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	AddStopped               types.Bool   `tfsdk:"add_stopped"`
 	SaveMagnetFiles          types.Bool   `tfsdk:"save_magnet_files"`
 	ReadOnly                 types.Bool   `tfsdk:"read_only"`
@@ -92,11 +137,14 @@ type DownloadClient struct {
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClient) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
+			"test_on_apply":              types.BoolType,
+			"extra_fields":               types.StringType,
 			"tags":                       types.SetType{}.WithElementType(types.Int64Type),
 			"additional_tags":            types.SetType{}.WithElementType(types.Int64Type),
 			"post_import_tags":           types.SetType{}.WithElementType(types.StringType),
@@ -113,6 +161,10 @@ func (d DownloadClient) getType() attr.Type {
 			"host":                       types.StringType,
 			"config_contract":            types.StringType,
 			"destination":                types.StringType,
+			"destination_directory":      types.StringType,
+			"api_url":                    types.StringType,
+			"app_id":                     types.StringType,
+			"app_token":                  types.StringType,
 			"tv_directory":               types.StringType,
 			"username":                   types.StringType,
 			"tv_imported_category":       types.StringType,
@@ -123,9 +175,16 @@ func (d DownloadClient) getType() attr.Type {
 			"url_base":                   types.StringType,
 			"api_key":                    types.StringType,
 			"recent_tv_priority":         types.Int64Type,
+			"recent_tv_priority_name":    types.StringType,
+			"older_tv_priority_name":     types.StringType,
+			"initial_state_name":         types.StringType,
+			"recent_priority_name":       types.StringType,
+			"older_priority_name":        types.StringType,
 			"intial_state":               types.Int64Type,
 			"initial_state":              types.Int64Type,
 			"older_tv_priority":          types.Int64Type,
+			"recent_priority":            types.Int64Type,
+			"older_priority":             types.Int64Type,
 			"priority":                   types.Int64Type,
 			"port":                       types.Int64Type,
 			"id":                         types.Int64Type,
@@ -149,8 +208,17 @@ func (r *DownloadClientResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nGeneric Download Client resource. When possible use a specific resource instead.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields as JSON object (e.g. `jsonencode({ newField = true })`), to manage fields not supported by any other attribute. They are merged with the other fields, overriding them. When unset, it shows all the fields returned by Sonarr not supported by any other attribute.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -170,6 +238,9 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"config_contract": schema.StringAttribute{
 				MarkdownDescription: "DownloadClient configuration template.",
@@ -196,6 +267,8 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -248,11 +321,15 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
@@ -261,27 +338,92 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
-			"initial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause.",
+			"recent_priority": schema.Int64Attribute{
+				MarkdownDescription: "Recent priority. `0` Last, `1` First.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1),
+				},
+			},
+			"older_priority": schema.Int64Attribute{
+				MarkdownDescription: "Older priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"initial_state": schema.Int64Attribute{
+				MarkdownDescription: "Initial state. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+				Optional:            true,
+				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("initial_state_name"),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3),
+				},
+			},
 			"intial_state": schema.Int64Attribute{
-				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
+				MarkdownDescription: "Misspelled alias of `initial_state`.",
+				Optional:            true,
+				Computed:            true,
+				DeprecationMessage:  downloadClientIntialStateDeprecation,
+				Validators: []validator.Int64{
+					int64validator.ConflictsWith(path.MatchRoot("initial_state")),
+				},
+			},
+			"recent_tv_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Recent TV priority. Alternative to `recent_tv_priority`, allowed values depend on the implementation.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("recent_tv_priority")),
+				},
+			},
+			"older_tv_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Older TV priority. Alternative to `older_tv_priority`, allowed values depend on the implementation.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("older_tv_priority")),
+				},
+			},
+			"recent_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Recent priority. Alternative to `recent_priority`, allowed values depend on the implementation.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("recent_priority")),
+				},
+			},
+			"older_priority_name": schema.StringAttribute{
+				MarkdownDescription: "Older priority. Alternative to `older_priority`, allowed values depend on the implementation.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("older_priority")),
+				},
+			},
+			"initial_state_name": schema.StringAttribute{
+				MarkdownDescription: "Initial state. Alternative to `initial_state` and `intial_state`, allowed values depend on the implementation.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("initial_state"), path.MatchRoot("intial_state")),
+				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key.",
@@ -299,6 +441,22 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "API URL.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "App ID.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"app_token": schema.StringAttribute{
+				MarkdownDescription: "App token.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
 			"secret_token": schema.StringAttribute{
 				MarkdownDescription: "Secret token.",
 				Optional:            true,
@@ -336,6 +494,11 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"destination_directory": schema.StringAttribute{
+				MarkdownDescription: "Destination directory.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"category": schema.StringAttribute{
 				MarkdownDescription: "Category.",
 				Optional:            true,
@@ -388,10 +551,35 @@ func (r *DownloadClientResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *DownloadClientResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeDownloadClientInitialState},
+	}
+}
+
+func (r *DownloadClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var client DownloadClient
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &client)...)
+
+	if client.Implementation.IsUnknown() {
+		return
+	}
+
+	priorities := downloadClientPriorities[client.Implementation.ValueString()]
+	priorities.validate(client.RecentTvPriorityName, "recent_tv_priority_name", &resp.Diagnostics)
+	priorities.validate(client.OlderTvPriorityName, "older_tv_priority_name", &resp.Diagnostics)
+	priorities.validate(client.RecentPriorityName, "recent_priority_name", &resp.Diagnostics)
+	priorities.validate(client.OlderPriorityName, "older_priority_name", &resp.Diagnostics)
+	downloadClientInitialStates[client.Implementation.ValueString()].validate(client.InitialStateName, "initial_state_name", &resp.Diagnostics)
+}
+
 func (r *DownloadClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -408,7 +596,11 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	// Create new DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientResourceName, err))
 
@@ -420,6 +612,9 @@ func (r *DownloadClientResource) Create(ctx context.Context, req resource.Create
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state DownloadClient
 
+	state.ExtraFields = client.ExtraFields
+	state.TestOnApply = client.TestOnApply
+	state.SecretsRevision = client.SecretsRevision
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -448,6 +643,9 @@ func (r *DownloadClientResource) Read(ctx context.Context, req resource.ReadRequ
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state DownloadClient
 
+	state.ExtraFields = client.ExtraFields
+	state.TestOnApply = client.TestOnApply
+	state.SecretsRevision = client.SecretsRevision
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -466,6 +664,10 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	// Update DownloadClient
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientResourceName, err))
@@ -478,6 +680,9 @@ func (r *DownloadClientResource) Update(ctx context.Context, req resource.Update
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state DownloadClient
 
+	state.ExtraFields = client.ExtraFields
+	state.TestOnApply = client.TestOnApply
+	state.SecretsRevision = client.SecretsRevision
 	state.writeSensitive(client)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -505,7 +710,7 @@ func (r *DownloadClientResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *DownloadClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientResourceName, findDownloadClientIDs(r.auth, r.client, "", ""))
 	tflog.Trace(ctx, "imported "+downloadClientResourceName+": "+req.ID)
 }
 
@@ -528,6 +733,18 @@ func (d *DownloadClient) write(ctx context.Context, downloadClient *sonarr.Downl
 	d.FieldTags = types.SetValueMust(types.StringType, nil)
 	d.PostImportTags = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, d, downloadClient.GetFields(), downloadClientFields)
+	d.ExtraFields = helpers.WriteExtraFields(d.ExtraFields, downloadClient.GetFields(), downloadClientFields)
+
+	priorities := downloadClientPriorities[d.Implementation.ValueString()]
+	d.RecentTvPriorityName = priorities.name(d.RecentTvPriority)
+	d.OlderTvPriorityName = priorities.name(d.OlderTvPriority)
+	d.RecentPriorityName = priorities.name(d.RecentPriority)
+	d.OlderPriorityName = priorities.name(d.OlderPriority)
+
+	// intial_state is kept as a deprecated alias of initial_state
+	d.InitialState = *d.apiInitialState()
+	d.IntialState = d.InitialState
+	d.InitialStateName = downloadClientInitialStates[d.Implementation.ValueString()].name(d.InitialState)
 }
 
 func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.DownloadClientResource {
@@ -542,11 +759,59 @@ func (d *DownloadClient) read(ctx context.Context, diags *diag.Diagnostics) *son
 	client.SetName(d.Name.ValueString())
 	client.SetProtocol(sonarr.DownloadProtocol(d.Protocol.ValueString()))
 	diags.Append(d.Tags.ElementsAs(ctx, &client.Tags, true)...)
-	client.SetFields(helpers.ReadFields(ctx, d, downloadClientFields))
+
+	// the enum names are sent as their integer values
+	fields := *d
+	priorities := downloadClientPriorities[d.Implementation.ValueString()]
+	fields.RecentTvPriority = priorities.value(d.RecentTvPriorityName, d.RecentTvPriority)
+	fields.OlderTvPriority = priorities.value(d.OlderTvPriorityName, d.OlderTvPriority)
+	fields.RecentPriority = priorities.value(d.RecentPriorityName, d.RecentPriority)
+	fields.OlderPriority = priorities.value(d.OlderPriorityName, d.OlderPriority)
+
+	initialState := d.InitialState
+	if initialState.IsNull() || initialState.IsUnknown() {
+		initialState = d.IntialState
+	}
+
+	fields.InitialState, fields.IntialState = types.Int64Null(), types.Int64Null()
+	*fields.apiInitialState() = downloadClientInitialStates[d.Implementation.ValueString()].value(d.InitialStateName, initialState)
+	client.SetFields(helpers.ReadExtraFields(d.ExtraFields, helpers.ReadFields(ctx, &fields, downloadClientFields)))
 
 	return client
 }
 
+// apiInitialState returns the field mapped to the initial state sent to Sonarr, misspelled by some implementations.
+func (d *DownloadClient) apiInitialState() *types.Int64 {
+	if d.Implementation.ValueString() == downloadClientUtorrentImplementation {
+		return &d.IntialState
+	}
+
+	return &d.InitialState
+}
+
+// upgradeDownloadClientInitialState moves the misspelled intial_state of the prior state into initial_state.
+func upgradeDownloadClientInitialState(_ context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(req.RawState.JSON, &state); err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, "Unable to read prior state, got error: "+err.Error())
+
+		return
+	}
+
+	if initialState, ok := state["initial_state"]; !ok || string(initialState) == "null" {
+		state["initial_state"] = state["intial_state"]
+	}
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ResourceError, "Unable to upgrade state, got error: "+err.Error())
+
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{JSON: data}
+}
+
 // writeSensitive copy sensitive data from another resource.
 func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 	if !client.Password.IsUnknown() {
@@ -560,4 +825,20 @@ func (d *DownloadClient) writeSensitive(client *DownloadClient) {
 	if !client.SecretToken.IsUnknown() {
 		d.SecretToken = client.SecretToken
 	}
+
+	if !client.AppToken.IsUnknown() {
+		d.AppToken = client.AppToken
+	}
+}
+
+// findDownloadClientIDs returns a function listing the IDs of the download clients with the given name.
+// Unless empty, implementation and protocol must match too, as for adoption.
+func findDownloadClientIDs(auth context.Context, client *sonarr.APIClient, implementation, protocol string) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.DownloadClientResource, error) {
+		response, _, err := client.DownloadClientAPI.ListDownloadClient(auth).Execute()
+
+		return response, err
+	}, (*sonarr.DownloadClientResource).GetName, (*sonarr.DownloadClientResource).GetId, func(item *sonarr.DownloadClientResource) bool {
+		return implementation == "" || checkAdoptable(item.GetName(), implementation, item.GetImplementation(), protocol, string(item.GetProtocol())) == nil
+	})
 }
@@ -1,11 +1,17 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
+	tfresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccDownloadClientResource(t *testing.T) {
@@ -27,6 +33,7 @@ func TestAccDownloadClientResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_download_client.test", "enable", "false"),
 					resource.TestCheckResourceAttr("sonarr_download_client.test", "url_base", "/transmission/"),
 					resource.TestCheckResourceAttrSet("sonarr_download_client.test", "id"),
+					resource.TestCheckResourceAttrSet("sonarr_download_client.test", "extra_fields"),
 				),
 			},
 			// Unauthorized Read
@@ -89,3 +96,85 @@ func testAccDownloadClientResourceConfig(name, enable string) string {
 	}
 	`, enable, name, name)
 }
+
+func TestUpgradeDownloadClientInitialState(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		prior    string
+		expected string
+	}{
+		"misspelled": {
+			prior:    `{"id":1,"intial_state":2}`,
+			expected: `{"id":1,"initial_state":2,"intial_state":2}`,
+		},
+		"null": {
+			prior:    `{"id":1,"initial_state":null,"intial_state":3}`,
+			expected: `{"id":1,"initial_state":3,"intial_state":3}`,
+		},
+		"set": {
+			prior:    `{"id":1,"initial_state":1,"intial_state":null}`,
+			expected: `{"id":1,"initial_state":1,"intial_state":null}`,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := tfresource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(test.prior)}}
+			resp := &tfresource.UpgradeStateResponse{}
+
+			upgradeDownloadClientInitialState(context.Background(), req, resp)
+			assert.False(t, resp.Diagnostics.HasError())
+			assert.JSONEq(t, test.expected, string(resp.DynamicValue.JSON))
+		})
+	}
+}
+
+func TestFindDownloadClientIDs(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id":3,"name":"shared","implementation":"Transmission","protocol":"torrent"},
+			{"id":4,"name":"shared","implementation":"QBittorrent","protocol":"torrent"},
+			{"id":5,"name":"other","implementation":"QBittorrent","protocol":"torrent"}
+		]`))
+	}))
+	t.Cleanup(server.Close)
+
+	client, auth := testFakeClient(server.URL)
+
+	tests := map[string]struct {
+		implementation string
+		protocol       string
+		expected       []int32
+	}{
+		"any": {
+			expected: []int32{3, 4},
+		},
+		"typed": {
+			implementation: "QBittorrent",
+			protocol:       "torrent",
+			expected:       []int32{4},
+		},
+		"other_protocol": {
+			implementation: "QBittorrent",
+			protocol:       "usenet",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ids, err := findDownloadClientIDs(auth, client, test.implementation, test.protocol)("shared")
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, ids)
+		})
+	}
+}
@@ -25,6 +25,13 @@ const (
 	downloadClientRtorrentProtocol       = "torrent"
 )
 
+var downloadClientRtorrentPriorities = intEnum{
+	0: "very_low",
+	1: "low",
+	2: "normal",
+	3: "high",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &DownloadClientRtorrentResource{}
@@ -37,8 +44,10 @@ func NewDownloadClientRtorrentResource() resource.Resource {
 
 // DownloadClientRtorrentResource defines the download client implementation.
 type DownloadClientRtorrentResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientRtorrent describes the download client data model.
@@ -52,16 +61,20 @@ type DownloadClientRtorrent struct {
 	TvCategory               types.String `tfsdk:"tv_category"`
 	TvDirectory              types.String `tfsdk:"tv_directory"`
 	TvImportedCategory       types.String `tfsdk:"tv_imported_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	AddStopped               types.Bool   `tfsdk:"add_stopped"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientRtorrent) toDownloadClient() *DownloadClient {
@@ -76,6 +89,8 @@ func (d DownloadClientRtorrent) toDownloadClient() *DownloadClient {
 		TvDirectory:              d.TvDirectory,
 		TvImportedCategory:       d.TvImportedCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
@@ -102,6 +117,8 @@ func (d *DownloadClientRtorrent) fromDownloadClient(client *DownloadClient) {
 	d.TvDirectory = client.TvDirectory
 	d.TvImportedCategory = client.TvImportedCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
@@ -140,6 +157,9 @@ func (r *DownloadClientRtorrentResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -151,6 +171,8 @@ func (r *DownloadClientRtorrentResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -173,27 +195,37 @@ func (r *DownloadClientRtorrentResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` VeryLow, `1` Low, `2` Normal, `3` High.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientRtorrentPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `0` VeryLow, `1` Low, `2` Normal, `3` High.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3),
 				},
 			},
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientRtorrentPriorities),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -231,9 +263,11 @@ func (r *DownloadClientRtorrentResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *DownloadClientRtorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -250,7 +284,11 @@ func (r *DownloadClientRtorrentResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientRtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientRtorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientRtorrentResourceName, err))
 
@@ -300,6 +338,10 @@ func (r *DownloadClientRtorrentResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientRtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientRtorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientRtorrentResourceName, err))
@@ -335,7 +377,7 @@ func (r *DownloadClientRtorrentResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientRtorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientRtorrentResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientRtorrentImplementation, downloadClientRtorrentProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientRtorrentResourceName+": "+req.ID)
 }
 
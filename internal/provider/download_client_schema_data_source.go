@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const downloadClientSchemaDataSourceName = "download_client_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &DownloadClientSchemaDataSource{}
+
+func NewDownloadClientSchemaDataSource() datasource.DataSource {
+	return &DownloadClientSchemaDataSource{}
+}
+
+// DownloadClientSchemaDataSource defines the download client schema implementation.
+type DownloadClientSchemaDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *DownloadClientSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + downloadClientSchemaDataSourceName
+}
+
+func (d *DownloadClientSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = implementationSchemaDataSourceSchema("Download Clients", "Download Client", "download_client")
+}
+
+func (d *DownloadClientSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *DownloadClientSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ImplementationSchemas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get download client schema current value
+	response, _, err := d.client.DownloadClientAPI.ListDownloadClientSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+downloadClientSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	implementations := make([]implementationSchemaResource, len(response))
+	for i := range response {
+		implementations[i] = &response[i]
+	}
+
+	data.write(ctx, implementations, &DownloadClient{}, downloadClientFields, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDownloadClientSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccDownloadClientSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccDownloadClientSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_download_client_schema.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_download_client_schema.test", "schemas.*", map[string]string{"implementation": "Transmission"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccDownloadClientSchemaDataSourceConfig = `
+data "sonarr_download_client_schema" "test" {
+	implementation = "Transmission"
+}
+`
@@ -25,10 +25,18 @@ const (
 	downloadClientUtorrentProtocol       = "torrent"
 )
 
+var downloadClientUtorrentInitialStates = intEnum{
+	0: "start",
+	1: "force_start",
+	2: "pause",
+	3: "stop",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &DownloadClientUtorrentResource{}
-	_ resource.ResourceWithImportState = &DownloadClientUtorrentResource{}
+	_ resource.Resource                 = &DownloadClientUtorrentResource{}
+	_ resource.ResourceWithImportState  = &DownloadClientUtorrentResource{}
+	_ resource.ResourceWithUpgradeState = &DownloadClientUtorrentResource{}
 )
 
 func NewDownloadClientUtorrentResource() resource.Resource {
@@ -37,8 +45,10 @@ func NewDownloadClientUtorrentResource() resource.Resource {
 
 // DownloadClientUtorrentResource defines the download client implementation.
 type DownloadClientUtorrentResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // DownloadClientUtorrent describes the download client data model.
@@ -51,16 +61,22 @@ type DownloadClientUtorrent struct {
 	Username                 types.String `tfsdk:"username"`
 	Password                 types.String `tfsdk:"password"`
 	TvCategory               types.String `tfsdk:"tv_category"`
+	RecentTvPriorityName     types.String `tfsdk:"recent_tv_priority_name"`
+	OlderTvPriorityName      types.String `tfsdk:"older_tv_priority_name"`
+	InitialStateName         types.String `tfsdk:"initial_state_name"`
 	RecentTvPriority         types.Int64  `tfsdk:"recent_tv_priority"`
 	Priority                 types.Int64  `tfsdk:"priority"`
 	Port                     types.Int64  `tfsdk:"port"`
 	ID                       types.Int64  `tfsdk:"id"`
 	OlderTvPriority          types.Int64  `tfsdk:"older_tv_priority"`
+	InitialState             types.Int64  `tfsdk:"initial_state"`
 	IntialState              types.Int64  `tfsdk:"intial_state"`
+	SecretsRevision          types.Int64  `tfsdk:"secrets_revision"`
 	UseSsl                   types.Bool   `tfsdk:"use_ssl"`
 	Enable                   types.Bool   `tfsdk:"enable"`
 	RemoveFailedDownloads    types.Bool   `tfsdk:"remove_failed_downloads"`
 	RemoveCompletedDownloads types.Bool   `tfsdk:"remove_completed_downloads"`
+	TestOnApply              types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (d DownloadClientUtorrent) toDownloadClient() *DownloadClient {
@@ -73,12 +89,16 @@ func (d DownloadClientUtorrent) toDownloadClient() *DownloadClient {
 		Password:                 d.Password,
 		TvCategory:               d.TvCategory,
 		RecentTvPriority:         d.RecentTvPriority,
+		RecentTvPriorityName:     d.RecentTvPriorityName,
+		OlderTvPriorityName:      d.OlderTvPriorityName,
 		OlderTvPriority:          d.OlderTvPriority,
 		Priority:                 d.Priority,
 		Port:                     d.Port,
 		ID:                       d.ID,
 		TvImportedCategory:       d.TvImportedCategory,
+		InitialState:             d.InitialState,
 		IntialState:              d.IntialState,
+		InitialStateName:         d.InitialStateName,
 		UseSsl:                   d.UseSsl,
 		Enable:                   d.Enable,
 		RemoveFailedDownloads:    d.RemoveFailedDownloads,
@@ -98,12 +118,16 @@ func (d *DownloadClientUtorrent) fromDownloadClient(client *DownloadClient) {
 	d.Password = client.Password
 	d.TvCategory = client.TvCategory
 	d.RecentTvPriority = client.RecentTvPriority
+	d.RecentTvPriorityName = client.RecentTvPriorityName
+	d.OlderTvPriorityName = client.OlderTvPriorityName
 	d.OlderTvPriority = client.OlderTvPriority
 	d.Priority = client.Priority
 	d.Port = client.Port
 	d.ID = client.ID
 	d.TvImportedCategory = client.TvImportedCategory
+	d.InitialState = client.InitialState
 	d.IntialState = client.IntialState
+	d.InitialStateName = client.InitialStateName
 	d.UseSsl = client.UseSsl
 	d.Enable = client.Enable
 	d.RemoveFailedDownloads = client.RemoveFailedDownloads
@@ -116,6 +140,7 @@ func (r *DownloadClientUtorrentResource) Metadata(_ context.Context, req resourc
 
 func (r *DownloadClientUtorrentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "<!-- subcategory:Download Clients -->\nDownload Client uTorrent resource.\nFor more information refer to [Download Client](https://wiki.servarr.com/sonarr/settings#download-clients) and [uTorrent](https://wiki.servarr.com/sonarr/supported#utorrent).",
 		Attributes: map[string]schema.Attribute{
 			"enable": schema.BoolAttribute{
@@ -137,6 +162,9 @@ func (r *DownloadClientUtorrentResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"name": schema.StringAttribute{
 				MarkdownDescription: "Download Client name.",
@@ -148,6 +176,8 @@ func (r *DownloadClientUtorrentResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Download Client ID.",
 				Computed:            true,
@@ -165,35 +195,57 @@ func (r *DownloadClientUtorrentResource) Schema(_ context.Context, _ resource.Sc
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"recent_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Recent TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("recent_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
+			"recent_tv_priority_name": intEnumAttribute("Recent TV priority.", "recent_tv_priority", downloadClientTorrentPriorities),
 			"older_tv_priority": schema.Int64Attribute{
 				MarkdownDescription: "Older TV priority. `0` Last, `1` First.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("older_tv_priority_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1),
 				},
 			},
-			"intial_state": schema.Int64Attribute{
+			"older_tv_priority_name": intEnumAttribute("Older TV priority.", "older_tv_priority", downloadClientTorrentPriorities),
+			"initial_state": schema.Int64Attribute{
 				MarkdownDescription: "Initial state, with Stop support. `0` Start, `1` ForceStart, `2` Pause, `3` Stop.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("initial_state_name"),
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3),
+				},
+			},
+			"intial_state": schema.Int64Attribute{
+				MarkdownDescription: "Misspelled alias of `initial_state`.",
+				Optional:            true,
+				Computed:            true,
+				DeprecationMessage:  downloadClientIntialStateDeprecation,
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3),
+					int64validator.ConflictsWith(path.MatchRoot("initial_state"), path.MatchRoot("initial_state_name")),
 				},
 			},
+			"initial_state_name": intEnumAttribute("Initial state.", "initial_state", downloadClientUtorrentInitialStates),
 			"host": schema.StringAttribute{
 				MarkdownDescription: "host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
@@ -225,10 +277,18 @@ func (r *DownloadClientUtorrentResource) Schema(_ context.Context, _ resource.Sc
 	}
 }
 
+func (r *DownloadClientUtorrentResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeDownloadClientInitialState},
+	}
+}
+
 func (r *DownloadClientUtorrentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -245,7 +305,11 @@ func (r *DownloadClientUtorrentResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientUtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientUtorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createDownloadClient(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientUtorrentResourceName, err))
 
@@ -295,6 +359,10 @@ func (r *DownloadClientUtorrentResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientUtorrent
 	request := client.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(client.TestOnApply, r.testOnApply) && !testDownloadClient(r.auth, r.client, request, downloadClientUtorrentResourceName, client, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, request.GetId()).DownloadClientResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientUtorrentResourceName, err))
@@ -330,7 +398,7 @@ func (r *DownloadClientUtorrentResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *DownloadClientUtorrentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, downloadClientUtorrentResourceName, findDownloadClientIDs(r.auth, r.client, downloadClientUtorrentImplementation, downloadClientUtorrentProtocol))
 	tflog.Trace(ctx, "imported "+downloadClientUtorrentResourceName+": "+req.ID)
 }
 
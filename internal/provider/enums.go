@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// intEnum maps the values of a Sonarr integer enum to human-readable names.
+type intEnum map[int64]string
+
+// names returns the enum names, ordered by value.
+func (e intEnum) names() []string {
+	values := make([]int64, 0, len(e))
+	for value := range e {
+		values = append(values, value)
+	}
+
+	slices.Sort(values)
+
+	names := make([]string, len(values))
+	for i, value := range values {
+		names[i] = e[value]
+	}
+
+	return names
+}
+
+// description lists the enum names for the attribute documentation.
+func (e intEnum) description() string {
+	return "`" + strings.Join(e.names(), "`, `") + "`"
+}
+
+// name returns the name of the value, null if the value is not part of the enum.
+func (e intEnum) name(value types.Int64) types.String {
+	if name, ok := e[value.ValueInt64()]; ok && !value.IsNull() && !value.IsUnknown() {
+		return types.StringValue(name)
+	}
+
+	return types.StringNull()
+}
+
+// value returns the value for the configured name, falling back to the integer attribute when the name is not set.
+func (e intEnum) value(name types.String, value types.Int64) types.Int64 {
+	if name.IsNull() || name.IsUnknown() {
+		return value
+	}
+
+	for enumValue, enumName := range e {
+		if enumName == name.ValueString() {
+			return types.Int64Value(enumValue)
+		}
+	}
+
+	return value
+}
+
+// validate checks that the configured name is part of the enum.
+func (e intEnum) validate(name types.String, attribute string, diags *diag.Diagnostics) {
+	if name.IsNull() || name.IsUnknown() || slices.Contains(e.names(), name.ValueString()) {
+		return
+	}
+
+	if len(e) == 0 {
+		diags.AddAttributeError(
+			path.Root(attribute),
+			"Unsupported Attribute",
+			fmt.Sprintf("Attribute %s is not supported by the implementation.", attribute),
+		)
+
+		return
+	}
+
+	diags.AddAttributeError(
+		path.Root(attribute),
+		"Invalid Attribute Value Match",
+		fmt.Sprintf("Attribute %s value must be one of: %s, got: %q", attribute, e.description(), name.ValueString()),
+	)
+}
+
+// intEnumAttribute returns the string alternative to the deprecated integer attribute.
+func intEnumAttribute(description, integerAttribute string, enum intEnum) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("%s Alternative to `%s`. Allowed values: %s.", description, integerAttribute, enum.description()),
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(enum.names()...),
+			stringvalidator.ConflictsWith(path.MatchRoot(integerAttribute)),
+		},
+	}
+}
+
+// intEnumDeprecation is the deprecation message of the integer attributes with a string alternative.
+func intEnumDeprecation(nameAttribute string) string {
+	return fmt.Sprintf("Use `%s` instead, this attribute will be removed in a future major version.", nameAttribute)
+}
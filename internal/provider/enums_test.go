@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntEnum(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		name     types.String
+		value    types.Int64
+		expected types.Int64
+		err      bool
+	}{
+		"name": {
+			name:     types.StringValue("prefixed_range"),
+			value:    types.Int64Unknown(),
+			expected: types.Int64Value(5),
+		},
+		"value": {
+			name:     types.StringUnknown(),
+			value:    types.Int64Value(4),
+			expected: types.Int64Value(4),
+		},
+		"invalid": {
+			name:     types.StringValue("prefixed"),
+			value:    types.Int64Null(),
+			expected: types.Int64Null(),
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+
+			value := namingMultiEpisodeStyles.value(test.name, test.value)
+			namingMultiEpisodeStyles.validate(test.name, "multi_episode_style_name", &diags)
+			assert.Equal(t, test.expected, value)
+			assert.Equal(t, test.err, diags.HasError())
+
+			if !test.err {
+				assert.Equal(t, namingMultiEpisodeStyles[value.ValueInt64()], namingMultiEpisodeStyles.name(value).ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,541 @@
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	fakeSonarrAPIKey   = "FakeSonarrAPIKey"
+	fakeSonarrBasePath = "/api/v3/"
+)
+
+// fakeSonarrSeeds are the objects available on a fresh Sonarr instance.
+var fakeSonarrSeeds = map[string]string{
+	"delayprofile":   `[{"id":1,"enableUsenet":true,"enableTorrent":true,"preferredProtocol":"usenet","usenetDelay":0,"torrentDelay":0,"bypassIfHighestQuality":true,"bypassIfAboveCustomFormatScore":false,"minimumCustomFormatScore":0,"order":2147483647,"tags":[]}]`,
+	"qualityprofile": `[{"id":1,"name":"Any","upgradeAllowed":false,"cutoff":1,"minFormatScore":0,"cutoffFormatScore":0,"minUpgradeFormatScore":1,"formatItems":[],"items":[{"quality":{"id":1,"name":"SDTV","source":"television","resolution":480},"items":[],"allowed":true}]}]`,
+	"language":       `[{"id":0,"name":"Unknown","nameLower":"unknown"},{"id":1,"name":"English","nameLower":"english"},{"id":2,"name":"French","nameLower":"french"},{"id":3,"name":"Spanish","nameLower":"spanish"},{"id":4,"name":"German","nameLower":"german"},{"id":5,"name":"Italian","nameLower":"italian"},{"id":6,"name":"Danish","nameLower":"danish"},{"id":7,"name":"Dutch","nameLower":"dutch"},{"id":8,"name":"Japanese","nameLower":"japanese"},{"id":9,"name":"Icelandic","nameLower":"icelandic"},{"id":10,"name":"Chinese","nameLower":"chinese"},{"id":11,"name":"Russian","nameLower":"russian"},{"id":12,"name":"Polish","nameLower":"polish"},{"id":13,"name":"Vietnamese","nameLower":"vietnamese"},{"id":14,"name":"Swedish","nameLower":"swedish"},{"id":15,"name":"Norwegian","nameLower":"norwegian"},{"id":16,"name":"Finnish","nameLower":"finnish"},{"id":17,"name":"Turkish","nameLower":"turkish"},{"id":18,"name":"Portuguese","nameLower":"portuguese"},{"id":19,"name":"Flemish","nameLower":"flemish"},{"id":20,"name":"Greek","nameLower":"greek"},{"id":21,"name":"Korean","nameLower":"korean"},{"id":22,"name":"Hungarian","nameLower":"hungarian"},{"id":23,"name":"Hebrew","nameLower":"hebrew"},{"id":24,"name":"Lithuanian","nameLower":"lithuanian"},{"id":25,"name":"Czech","nameLower":"czech"},{"id":26,"name":"Arabic","nameLower":"arabic"},{"id":27,"name":"Hindi","nameLower":"hindi"},{"id":28,"name":"Bulgarian","nameLower":"bulgarian"},{"id":29,"name":"Malayalam","nameLower":"malayalam"},{"id":30,"name":"Ukrainian","nameLower":"ukrainian"},{"id":31,"name":"Slovak","nameLower":"slovak"},{"id":32,"name":"Thai","nameLower":"thai"},{"id":33,"name":"Portuguese (Brazil)","nameLower":"portuguese (brazil)"},{"id":34,"name":"Spanish (Latino)","nameLower":"spanish (latino)"},{"id":35,"name":"Romanian","nameLower":"romanian"},{"id":36,"name":"Latvian","nameLower":"latvian"},{"id":37,"name":"Persian","nameLower":"persian"},{"id":38,"name":"Catalan","nameLower":"catalan"},{"id":39,"name":"Croatian","nameLower":"croatian"},{"id":40,"name":"Serbian","nameLower":"serbian"},{"id":41,"name":"Bosnian","nameLower":"bosnian"},{"id":42,"name":"Estonian","nameLower":"estonian"},{"id":43,"name":"Tamil","nameLower":"tamil"},{"id":44,"name":"Indonesian","nameLower":"indonesian"},{"id":45,"name":"Macedonian","nameLower":"macedonian"},{"id":46,"name":"Slovenian","nameLower":"slovenian"}]`,
+	"qualitydefinition": `[` +
+		`{"id":1,"quality":{"id":0,"name":"Unknown","source":"unknown","resolution":0},"title":"Unknown","weight":1,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":2,"quality":{"id":1,"name":"SDTV","source":"television","resolution":480},"title":"SDTV","weight":2,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":3,"quality":{"id":12,"name":"WEBRip-480p","source":"webRip","resolution":480},"title":"WEBRip-480p","weight":3,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":4,"quality":{"id":8,"name":"WEBDL-480p","source":"web","resolution":480},"title":"WEBDL-480p","weight":4,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":5,"quality":{"id":2,"name":"DVD","source":"dvd","resolution":480},"title":"DVD","weight":5,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":6,"quality":{"id":13,"name":"Bluray-480p","source":"bluray","resolution":480},"title":"Bluray-480p","weight":6,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":7,"quality":{"id":22,"name":"Bluray-576p","source":"bluray","resolution":576},"title":"Bluray-576p","weight":7,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":8,"quality":{"id":4,"name":"HDTV-720p","source":"television","resolution":720},"title":"HDTV-720p","weight":8,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":9,"quality":{"id":9,"name":"HDTV-1080p","source":"television","resolution":1080},"title":"HDTV-1080p","weight":9,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":10,"quality":{"id":10,"name":"Raw-HD","source":"televisionRaw","resolution":1080},"title":"Raw-HD","weight":10,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":11,"quality":{"id":14,"name":"WEBRip-720p","source":"webRip","resolution":720},"title":"WEBRip-720p","weight":11,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":12,"quality":{"id":5,"name":"WEBDL-720p","source":"web","resolution":720},"title":"WEBDL-720p","weight":12,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":13,"quality":{"id":6,"name":"Bluray-720p","source":"bluray","resolution":720},"title":"Bluray-720p","weight":13,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":14,"quality":{"id":15,"name":"WEBRip-1080p","source":"webRip","resolution":1080},"title":"WEBRip-1080p","weight":14,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":15,"quality":{"id":3,"name":"WEBDL-1080p","source":"web","resolution":1080},"title":"WEBDL-1080p","weight":15,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":16,"quality":{"id":7,"name":"Bluray-1080p","source":"bluray","resolution":1080},"title":"Bluray-1080p","weight":16,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":17,"quality":{"id":20,"name":"Bluray-1080p Remux","source":"blurayRaw","resolution":1080},"title":"Bluray-1080p Remux","weight":17,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":18,"quality":{"id":16,"name":"HDTV-2160p","source":"television","resolution":2160},"title":"HDTV-2160p","weight":18,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":19,"quality":{"id":17,"name":"WEBRip-2160p","source":"webRip","resolution":2160},"title":"WEBRip-2160p","weight":19,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":20,"quality":{"id":18,"name":"WEBDL-2160p","source":"web","resolution":2160},"title":"WEBDL-2160p","weight":20,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":21,"quality":{"id":19,"name":"Bluray-2160p","source":"bluray","resolution":2160},"title":"Bluray-2160p","weight":21,"minSize":0,"maxSize":1000,"preferredSize":995},` +
+		`{"id":22,"quality":{"id":21,"name":"Bluray-2160p Remux","source":"blurayRaw","resolution":2160},"title":"Bluray-2160p Remux","weight":22,"minSize":0,"maxSize":1000,"preferredSize":995}]`,
+}
+
+// fakeSonarrConfigs are the config singletons available on a fresh Sonarr instance.
+var fakeSonarrConfigs = map[string]string{
+	"host":            `{"id":1,"bindAddress":"*","port":8989,"sslPort":9898,"enableSsl":false,"launchBrowser":true,"authenticationMethod":"none","authenticationRequired":"enabled","analyticsEnabled":false,"username":"","password":"","logLevel":"info","consoleLogLevel":"","branch":"main","apiKey":"` + fakeSonarrAPIKey + `","sslCertPath":"","sslCertPassword":"","urlBase":"","instanceName":"Sonarr","applicationUrl":"","updateAutomatically":false,"updateMechanism":"docker","updateScriptPath":"","proxyEnabled":false,"proxyType":"http","proxyHostname":"","proxyPort":8080,"proxyUsername":"","proxyPassword":"","proxyBypassFilter":"","proxyBypassLocalAddresses":true,"certificateValidation":"enabled","backupFolder":"Backups","backupInterval":7,"backupRetention":28}`,
+	"naming":          `{"id":1,"renameEpisodes":false,"replaceIllegalCharacters":true,"colonReplacementFormat":4,"multiEpisodeStyle":0,"standardEpisodeFormat":"{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}","dailyEpisodeFormat":"{Series Title} - {Air-Date} - {Episode Title} {Quality Full}","animeEpisodeFormat":"{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}","seriesFolderFormat":"{Series Title}","seasonFolderFormat":"Season {season}","specialsFolderFormat":"Specials"}`,
+	"mediamanagement": `{"id":1,"autoUnmonitorPreviouslyDownloadedEpisodes":false,"recycleBin":"","recycleBinCleanupDays":7,"downloadPropersAndRepacks":"preferAndUpgrade","createEmptySeriesFolders":false,"deleteEmptyFolders":false,"fileDate":"none","rescanAfterRefresh":"always","setPermissionsLinux":false,"chmodFolder":"755","chownGroup":"","episodeTitleRequired":"always","skipFreeSpaceCheckWhenImporting":false,"minimumFreeSpaceWhenImporting":100,"copyUsingHardlinks":true,"useScriptImport":false,"scriptImportPath":"","importExtraFiles":false,"extraFileExtensions":"srt","enableMediaInfo":true}`,
+	"downloadclient":  `{"id":1,"downloadClientWorkingFolders":"_UNPACK_|_FAILED_","enableCompletedDownloadHandling":true,"autoRedownloadFailed":true,"autoRedownloadFailedFromInteractiveSearch":true}`,
+	"indexer":         `{"id":1,"minimumAge":0,"retention":0,"maximumSize":0,"rssSyncInterval":15}`,
+}
+
+// fakeSonarrSystemStatus is the static system status.
+const fakeSonarrSystemStatus = `{"appName":"Sonarr","instanceName":"Sonarr","version":"4.0.0.0","isDebug":false,"isProduction":true,"isAdmin":false,"isUserInteractive":false,"startupPath":"/app","appData":"/config","osName":"ubuntu","isDocker":true,"isLinux":true,"isOsx":false,"isWindows":false,"mode":"console","branch":"main","authentication":"none","databaseType":"sqLite","runtimeVersion":"6.0.0","urlBase":"","packageUpdateMechanism":"docker"}`
+
+// fakeSonarrProviders are the provider endpoints, with their implementations and the fields they support.
+var fakeSonarrProviders = map[string]struct {
+	fields          helpers.Fields
+	implementations []string
+}{
+	"downloadclient": {
+		fields:          downloadClientFields,
+		implementations: []string{"Aria2", "Deluge", "Flood", "Hadouken", "Nzbget", "Nzbvortex", "Pneumatic", "QBittorrent", "RTorrent", "Sabnzbd", "TorrentBlackhole", "TorrentDownloadStation", "TorrentFreeboxDownload", "Transmission", "UsenetBlackhole", "UsenetDownloadStation", "UTorrent", "Vuze"},
+	},
+	"indexer": {
+		fields:          indexerFields,
+		implementations: []string{"BroadcastheNet", "Fanzub", "FileList", "HDBits", "IPTorrents", "Newznab", "Nyaa", "TorrentRssIndexer", "Torrentleech", "Torznab"},
+	},
+	"notification": {
+		fields:          notificationFields,
+		implementations: []string{"Apprise", "CustomScript", "Discord", "Email", "MediaBrowser", "Gotify", "Join", "Xbmc", "Mailgun", "Notifiarr", "Ntfy", "PlexServer", "Prowl", "PushBullet", "Pushcut", "Pushover", "Sendgrid", "Signal", "Simplepush", "Slack", "SynologyIndexer", "Telegram", "Trakt", "Twitter", "Webhook"},
+	},
+	"importlist": {
+		fields:          importListFields,
+		implementations: []string{"AniListImport", "CustomImport", "ImdbListImport", "MyAnimeListImport", "PlexImport", "PlexRssImport", "SimklUserImport", "SonarrImport", "TraktListImport", "TraktPopularImport", "TraktUserImport"},
+	},
+	"metadata": {
+		fields:          metadataFields,
+		implementations: []string{"MediaBrowserMetadata", "PlexMetadata", "XbmcMetadata", "RoksboxMetadata", "WdtvMetadata"},
+	},
+}
+
+// fakeSonarr is an in memory fake of the Sonarr v3 API, used to run the acceptance tests without a real instance.
+// Every collection endpoint supports the usual list, create, get, update and delete operations.
+type fakeSonarr struct {
+	collections map[string]map[int]map[string]interface{}
+	configs     map[string]map[string]interface{}
+	nextID      map[string]int
+	mu          sync.Mutex
+}
+
+func newFakeSonarr() *fakeSonarr {
+	fake := &fakeSonarr{
+		collections: make(map[string]map[int]map[string]interface{}),
+		configs:     make(map[string]map[string]interface{}),
+		nextID:      make(map[string]int),
+	}
+
+	for collection, seed := range fakeSonarrSeeds {
+		var items []map[string]interface{}
+		if err := json.Unmarshal([]byte(seed), &items); err != nil {
+			panic(err)
+		}
+
+		for _, item := range items {
+			fake.store(collection, int(item["id"].(float64)), item)
+		}
+	}
+
+	for config, seed := range fakeSonarrConfigs {
+		var item map[string]interface{}
+		if err := json.Unmarshal([]byte(seed), &item); err != nil {
+			panic(err)
+		}
+
+		fake.configs[config] = item
+	}
+
+	return fake
+}
+
+// store saves the item in the collection, moving forward the next ID.
+func (f *fakeSonarr) store(collection string, id int, item map[string]interface{}) {
+	if f.collections[collection] == nil {
+		f.collections[collection] = make(map[int]map[string]interface{})
+	}
+
+	item["id"] = id
+	f.collections[collection][id] = item
+
+	if id >= f.nextID[collection] {
+		f.nextID[collection] = id + 1
+	}
+}
+
+// list returns the collection items sorted by ID.
+func (f *fakeSonarr) list(collection string) []map[string]interface{} {
+	items := make([]map[string]interface{}, 0, len(f.collections[collection]))
+	for _, item := range f.collections[collection] {
+		items = append(items, item)
+	}
+
+	slices.SortFunc(items, func(a, b map[string]interface{}) int { return a["id"].(int) - b["id"].(int) })
+
+	return items
+}
+
+func (f *fakeSonarr) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("X-Api-Key") != fakeSonarrAPIKey {
+		fakeSonarrWrite(w, http.StatusUnauthorized, nil)
+
+		return
+	}
+
+	if !strings.HasPrefix(r.URL.Path, fakeSonarrBasePath) {
+		fakeSonarrWrite(w, http.StatusNotFound, nil)
+
+		return
+	}
+
+	body := make(map[string]interface{})
+	if r.Method == http.MethodPost || r.Method == http.MethodPut {
+		// bulk requests and actions may send arrays, which are ignored
+		_ = json.NewDecoder(r.Body).Decode(&body)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, fakeSonarrBasePath), "/"), "/")
+	collection := segments[0]
+
+	switch {
+	case collection == "config" && len(segments) > 1:
+		f.serveConfig(w, r, segments[1], body)
+	case collection == "system" && len(segments) > 1 && segments[1] == "status":
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(fakeSonarrSystemStatus))
+	case collection == "parse":
+		f.serveParse(w, r.URL.Query().Get("title"))
+	case len(segments) == 1:
+		f.serveCollection(w, r, collection, body)
+	case segments[1] == "schema":
+		fakeSonarrWrite(w, http.StatusOK, fakeSonarrSchemas(collection))
+	case segments[1] == "lookup":
+		fakeSonarrWrite(w, http.StatusOK, []interface{}{})
+	default:
+		id, err := strconv.Atoi(segments[1])
+		if err != nil {
+			// test, testall, bulk and action endpoints always succeed
+			fakeSonarrWrite(w, http.StatusOK, map[string]interface{}{})
+
+			return
+		}
+
+		f.serveItem(w, r, collection, id, body)
+	}
+}
+
+func (f *fakeSonarr) serveConfig(w http.ResponseWriter, r *http.Request, name string, body map[string]interface{}) {
+	config, ok := f.configs[name]
+	if !ok {
+		fakeSonarrWrite(w, http.StatusNotFound, nil)
+
+		return
+	}
+
+	if r.Method == http.MethodPut {
+		for key, value := range body {
+			config[key] = value
+		}
+
+		config["id"] = 1
+	}
+
+	fakeSonarrWrite(w, http.StatusOK, config)
+}
+
+func (f *fakeSonarr) serveCollection(w http.ResponseWriter, r *http.Request, collection string, body map[string]interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		fakeSonarrWrite(w, http.StatusOK, f.list(collection))
+	case http.MethodPost:
+		fakeSonarrDefaultFields(collection, body)
+		f.store(collection, max(f.nextID[collection], 1), body)
+		fakeSonarrWrite(w, http.StatusCreated, body)
+	case http.MethodPut:
+		// some endpoints, like quality definitions, are updated without ID in path
+		id, _ := body["id"].(float64)
+		f.serveItem(w, r, collection, int(id), body)
+	default:
+		fakeSonarrWrite(w, http.StatusMethodNotAllowed, nil)
+	}
+}
+
+func (f *fakeSonarr) serveItem(w http.ResponseWriter, r *http.Request, collection string, id int, body map[string]interface{}) {
+	item, ok := f.collections[collection][id]
+	if !ok {
+		fakeSonarrWrite(w, http.StatusNotFound, map[string]interface{}{"message": "NotFound"})
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		fakeSonarrWrite(w, http.StatusOK, item)
+	case http.MethodPut:
+		fakeSonarrDefaultFields(collection, body)
+		f.store(collection, id, body)
+		fakeSonarrWrite(w, http.StatusAccepted, body)
+	case http.MethodDelete:
+		delete(f.collections[collection], id)
+		fakeSonarrWrite(w, http.StatusOK, map[string]interface{}{})
+	default:
+		fakeSonarrWrite(w, http.StatusMethodNotAllowed, nil)
+	}
+}
+
+var (
+	fakeSonarrEpisodeRegexp    = regexp.MustCompile(`(?i)\bS\d{1,2}(E\d{1,3})?\b`)
+	fakeSonarrResolutionRegexp = regexp.MustCompile(`(?i)\b(480|576|720|1080|2160)p\b`)
+	fakeSonarrSourceRegexps    = []struct {
+		regexp *regexp.Regexp
+		source string
+	}{
+		{regexp.MustCompile(`(?i)\bremux\b`), "blurayRaw"},
+		{regexp.MustCompile(`(?i)\bblu-?ray\b`), "bluray"},
+		{regexp.MustCompile(`(?i)\bweb-?dl\b`), "web"},
+		{regexp.MustCompile(`(?i)\bwebrip\b`), "webRip"},
+		{regexp.MustCompile(`(?i)\bhdtv\b`), "television"},
+	}
+)
+
+// serveParse parses source and resolution of the release title, and matches the custom formats release title conditions.
+// The other conditions never match, as the fake does not know anything else about the release.
+func (f *fakeSonarr) serveParse(w http.ResponseWriter, title string) {
+	if !fakeSonarrEpisodeRegexp.MatchString(title) {
+		fakeSonarrWrite(w, http.StatusOK, map[string]interface{}{"title": title})
+
+		return
+	}
+
+	source, resolution := "unknown", 0
+
+	for _, s := range fakeSonarrSourceRegexps {
+		if s.regexp.MatchString(title) {
+			source = s.source
+
+			break
+		}
+	}
+
+	if match := fakeSonarrResolutionRegexp.FindStringSubmatch(title); match != nil {
+		resolution, _ = strconv.Atoi(match[1])
+	}
+
+	// unknown quality, unless a definition matches
+	quality := f.collections["qualitydefinition"][1]["quality"]
+
+	for _, definition := range f.list("qualitydefinition") {
+		q := definition["quality"].(map[string]interface{})
+		if q["source"] == source && int(q["resolution"].(float64)) == resolution {
+			quality = q
+		}
+	}
+
+	formats := []map[string]interface{}{}
+
+	for _, format := range f.list("customformat") {
+		if fakeSonarrCustomFormatMatch(format, title) {
+			formats = append(formats, map[string]interface{}{"id": format["id"], "name": format["name"]})
+		}
+	}
+
+	languages := []interface{}{f.collections["language"][1]}
+
+	fakeSonarrWrite(w, http.StatusOK, map[string]interface{}{
+		"title": title,
+		"parsedEpisodeInfo": map[string]interface{}{
+			"releaseTitle": title,
+			"quality":      map[string]interface{}{"quality": quality},
+			"languages":    languages,
+		},
+		"languages":         languages,
+		"customFormats":     formats,
+		"customFormatScore": 0,
+	})
+}
+
+// fakeSonarrCustomFormatMatch matches like Sonarr does: every required condition and at least one condition per implementation.
+func fakeSonarrCustomFormatMatch(format map[string]interface{}, title string) bool {
+	specifications, _ := format["specifications"].([]interface{})
+	if len(specifications) == 0 {
+		return false
+	}
+
+	matches := make(map[string]bool)
+
+	for _, s := range specifications {
+		specification := s.(map[string]interface{})
+		implementation, _ := specification["implementation"].(string)
+		match := false
+
+		if implementation == "ReleaseTitleSpecification" {
+			fields, _ := specification["fields"].([]interface{})
+			for _, field := range fields {
+				if value, ok := field.(map[string]interface{})["value"].(string); ok && value != "" {
+					match, _ = regexp.MatchString("(?i)"+value, title)
+				}
+			}
+		}
+
+		if negate, _ := specification["negate"].(bool); negate {
+			match = !match
+		}
+
+		if required, _ := specification["required"].(bool); required && !match {
+			return false
+		}
+
+		matches[implementation] = matches[implementation] || match
+	}
+
+	for _, match := range matches {
+		if !match {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fakeSonarrDefaultFields adds the zero value of the provider fields not sent, like Sonarr does with the schema defaults.
+func fakeSonarrDefaultFields(collection string, body map[string]interface{}) {
+	provider, ok := fakeSonarrProviders[collection]
+	if !ok {
+		return
+	}
+
+	fields, _ := body["fields"].([]interface{})
+	sent := make(map[string]bool)
+
+	for _, field := range fields {
+		if name, ok := field.(map[string]interface{})["name"].(string); ok {
+			sent[name] = true
+		}
+	}
+
+	for _, field := range fakeSonarrZeroFields(provider.fields) {
+		if !sent[field["name"].(string)] {
+			fields = append(fields, field)
+		}
+	}
+
+	body["fields"] = fields
+}
+
+// fakeSonarrZeroFields returns all the fields of the list with their zero value.
+func fakeSonarrZeroFields(fieldLists helpers.Fields) []map[string]interface{} {
+	var fields []map[string]interface{}
+
+	add := func(names []string, value interface{}) {
+		for _, name := range names {
+			fields = append(fields, map[string]interface{}{"name": name, "value": value})
+		}
+	}
+
+	add(append(fieldLists.Bools, fieldLists.BoolsExceptions...), false)
+	add(append(fieldLists.Ints, fieldLists.IntsExceptions...), 0)
+	add(append(fieldLists.Strings, fieldLists.StringsExceptions...), "")
+	add(append(fieldLists.Floats, fieldLists.FloatsExceptions...), 0.0)
+	add(append(fieldLists.IntSlices, fieldLists.IntSlicesExceptions...), []int{})
+	add(append(fieldLists.StringSlices, fieldLists.StringSlicesExceptions...), []string{})
+
+	return fields
+}
+
+// fakeSonarrSchemas returns the implementation schemas of the provider endpoint.
+func fakeSonarrSchemas(collection string) []map[string]interface{} {
+	provider := fakeSonarrProviders[collection]
+	schemas := make([]map[string]interface{}, 0, len(provider.implementations))
+
+	for _, implementation := range provider.implementations {
+		schemas = append(schemas, map[string]interface{}{
+			"implementation":     implementation,
+			"implementationName": implementation,
+			"configContract":     implementation + "Settings",
+			"infoLink":           "https://wiki.servarr.com/sonarr/supported#" + strings.ToLower(implementation),
+			"fields":             fakeSonarrZeroFields(provider.fields),
+		})
+	}
+
+	return schemas
+}
+
+func fakeSonarrWrite(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func TestFakeSonarr(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(newFakeSonarr())
+	defer server.Close()
+
+	config := sonarr.NewConfiguration()
+	config.AddDefaultHeader("X-Api-Key", fakeSonarrAPIKey)
+	config.Servers[0].URL = server.URL
+	client := sonarr.NewAPIClient(config)
+	auth := t.Context()
+
+	// collections
+	tagRequest := sonarr.NewTagResource()
+	tagRequest.SetLabel("test")
+	tag, _, err := client.TagAPI.CreateTag(auth).TagResource(*tagRequest).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), tag.GetId())
+
+	tag.SetLabel("updated")
+	_, _, err = client.TagAPI.UpdateTag(auth, "1").TagResource(*tag).Execute()
+	assert.NoError(t, err)
+
+	tags, _, err := client.TagAPI.ListTag(auth).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "updated", tags[0].GetLabel())
+
+	_, err = client.TagAPI.DeleteTag(auth, 1).Execute()
+	assert.NoError(t, err)
+
+	_, _, err = client.TagAPI.GetTagById(auth, 1).Execute()
+	assert.Error(t, err)
+
+	// seeds and defaults
+	profile, _, err := client.DelayProfileAPI.GetDelayProfileById(auth, 1).Execute()
+	assert.NoError(t, err)
+	assert.True(t, profile.GetEnableUsenet())
+
+	request := sonarr.NewDownloadClientResource()
+	request.SetImplementation("Transmission")
+	field := sonarr.NewField()
+	field.SetName("host")
+	field.SetValue("transmission")
+	request.SetFields([]sonarr.Field{*field})
+	downloadClient, _, err := client.DownloadClientAPI.CreateDownloadClient(auth).DownloadClientResource(*request).Execute()
+	assert.NoError(t, err)
+	assert.Len(t, downloadClient.GetFields(), len(fakeSonarrZeroFields(downloadClientFields)))
+
+	// configs
+	naming, _, err := client.NamingConfigAPI.GetNamingConfig(auth).Execute()
+	assert.NoError(t, err)
+	naming.SetSeasonFolderFormat("S{season}")
+	naming, _, err = client.NamingConfigAPI.UpdateNamingConfig(auth, "1").NamingConfigResource(*naming).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "S{season}", naming.GetSeasonFolderFormat())
+
+	// parse
+	customFormat := sonarr.NewCustomFormatResource()
+	customFormat.SetName("x265")
+	specification := sonarr.NewCustomFormatSpecificationSchema()
+	specification.SetImplementation("ReleaseTitleSpecification")
+	specification.SetRequired(true)
+	specification.SetFields([]sonarr.Field{{Name: *sonarr.NewNullableString(sonarr.PtrString("value")), Value: "x265"}})
+	customFormat.SetSpecifications([]sonarr.CustomFormatSpecificationSchema{*specification})
+	_, _, err = client.CustomFormatAPI.CreateCustomFormat(auth).CustomFormatResource(*customFormat).Execute()
+	assert.NoError(t, err)
+
+	release, _, err := client.ParseAPI.GetParse(auth).Title("Show.S01.2160p.WEB-DL.DV.x265-GRP").Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "WEBDL-2160p", release.ParsedEpisodeInfo.Quality.Quality.GetName())
+	assert.Equal(t, "English", release.GetLanguages()[0].GetName())
+	assert.Len(t, release.GetCustomFormats(), 1)
+
+	release, _, err = client.ParseAPI.GetParse(auth).Title("Show.S01.1080p.BluRay.x264-GRP").Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, "Bluray-1080p", release.ParsedEpisodeInfo.Quality.Quality.GetName())
+	assert.Empty(t, release.GetCustomFormats())
+
+	release, _, err = client.ParseAPI.GetParse(auth).Title("not a release").Execute()
+	assert.NoError(t, err)
+	assert.Nil(t, release.ParsedEpisodeInfo)
+
+	// authentication
+	config.DefaultHeader["X-Api-Key"] = "wrong"
+	_, _, err = client.TagAPI.ListTag(auth).Execute()
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -44,19 +45,20 @@ type HostResource struct {
 
 // Host describes the host data model.
 type Host struct {
-	ProxyConfig    types.Object `tfsdk:"proxy"`
-	SSLConfig      types.Object `tfsdk:"ssl"`
-	AuthConfig     types.Object `tfsdk:"authentication"`
-	BackupConfig   types.Object `tfsdk:"backup"`
-	UpdateConfig   types.Object `tfsdk:"update"`
-	LoggingConfig  types.Object `tfsdk:"logging"`
-	InstanceName   types.String `tfsdk:"instance_name"`
-	ApplicationURL types.String `tfsdk:"application_url"`
-	BindAddress    types.String `tfsdk:"bind_address"`
-	URLBase        types.String `tfsdk:"url_base"`
-	ID             types.Int64  `tfsdk:"id"`
-	Port           types.Int64  `tfsdk:"port"`
-	LaunchBrowser  types.Bool   `tfsdk:"launch_browser"`
+	ProxyConfig     types.Object `tfsdk:"proxy"`
+	SSLConfig       types.Object `tfsdk:"ssl"`
+	AuthConfig      types.Object `tfsdk:"authentication"`
+	BackupConfig    types.Object `tfsdk:"backup"`
+	UpdateConfig    types.Object `tfsdk:"update"`
+	LoggingConfig   types.Object `tfsdk:"logging"`
+	InstanceName    types.String `tfsdk:"instance_name"`
+	ApplicationURL  types.String `tfsdk:"application_url"`
+	BindAddress     types.String `tfsdk:"bind_address"`
+	URLBase         types.String `tfsdk:"url_base"`
+	ID              types.Int64  `tfsdk:"id"`
+	Port            types.Int64  `tfsdk:"port"`
+	SecretsRevision types.Int64  `tfsdk:"secrets_revision"`
+	LaunchBrowser   types.Bool   `tfsdk:"launch_browser"`
 }
 
 // ProxyConfig is part of Host.
@@ -194,6 +196,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "TCP port.",
 				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Host ID.",
@@ -202,6 +207,7 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"secrets_revision": secretsRevisionAttribute,
 			"url_base": schema.StringAttribute{
 				MarkdownDescription: "URL base.",
 				Required:            true,
@@ -346,6 +352,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						MarkdownDescription: "SSL port.",
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
 					},
 					"enabled": schema.BoolAttribute{
 						MarkdownDescription: "Enabled.",
@@ -387,6 +396,9 @@ func (r *HostResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 						MarkdownDescription: "Proxy port.",
 						Optional:            true,
 						Computed:            true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 65535),
+						},
 					},
 					"bypass_local_addresses": schema.BoolAttribute{
 						MarkdownDescription: "Bypass for local addresses flag.",
@@ -631,7 +643,7 @@ func (h *Host) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.HostCo
 
 func (l *LoggingConfig) read(host *sonarr.HostConfigResource) {
 	host.SetAnalyticsEnabled(l.AnalyticsEnabled.ValueBool())
-	host.SetConsoleLogLevel(l.LogLevel.ValueString())
+	host.SetConsoleLogLevel(l.ConsoleLogLevel.ValueString())
 	host.SetLogLevel(l.LogLevel.ValueString())
 	host.SetLogSizeLimit(int32(l.LogSizeLimit.ValueInt64()))
 }
@@ -670,6 +682,7 @@ func (p *ProxyConfig) read(host *sonarr.HostConfigResource) {
 	host.SetProxyPassword(p.Password.ValueString())
 	host.SetProxyBypassFilter(p.BypassFilter.ValueString())
 	host.SetProxyHostname(p.Hostname.ValueString())
+	host.SetProxyType(sonarr.ProxyType(p.Type.ValueString()))
 	host.SetProxyPort(int32(p.Port.ValueInt64()))
 	host.SetProxyEnabled(p.Enabled.ValueBool())
 	host.SetProxyBypassLocalAddresses(p.BypassLocalAddresses.ValueBool())
@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// implementationSchemaResource is the common interface of the API resources exposing an implementation schema.
+type implementationSchemaResource interface {
+	GetImplementation() string
+	GetImplementationName() string
+	GetConfigContract() string
+	GetInfoLink() string
+	GetFields() []sonarr.Field
+}
+
+// ImplementationSchemas describes the implementation schemas data model.
+type ImplementationSchemas struct {
+	Schemas        types.Set    `tfsdk:"schemas"`
+	Implementation types.String `tfsdk:"implementation"`
+	ID             types.String `tfsdk:"id"`
+}
+
+// ImplementationSchema is part of ImplementationSchemas.
+type ImplementationSchema struct {
+	Fields             types.List   `tfsdk:"fields"`
+	Implementation     types.String `tfsdk:"implementation"`
+	ImplementationName types.String `tfsdk:"implementation_name"`
+	ConfigContract     types.String `tfsdk:"config_contract"`
+	InfoLink           types.String `tfsdk:"info_link"`
+}
+
+func (s ImplementationSchema) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"fields":              types.ListType{}.WithElementType(ImplementationSchemaField{}.getType()),
+			"implementation":      types.StringType,
+			"implementation_name": types.StringType,
+			"config_contract":     types.StringType,
+			"info_link":           types.StringType,
+		})
+}
+
+// ImplementationSchemaField is part of ImplementationSchema.
+type ImplementationSchemaField struct {
+	SelectOptions types.List   `tfsdk:"select_options"`
+	Name          types.String `tfsdk:"name"`
+	Attribute     types.String `tfsdk:"attribute"`
+	Label         types.String `tfsdk:"label"`
+	Type          types.String `tfsdk:"type"`
+	HelpText      types.String `tfsdk:"help_text"`
+	Unit          types.String `tfsdk:"unit"`
+	Privacy       types.String `tfsdk:"privacy"`
+	DefaultValue  types.String `tfsdk:"default_value"`
+	Order         types.Int64  `tfsdk:"order"`
+	Advanced      types.Bool   `tfsdk:"advanced"`
+}
+
+func (f ImplementationSchemaField) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"select_options": types.ListType{}.WithElementType(ImplementationSchemaSelectOption{}.getType()),
+			"name":           types.StringType,
+			"attribute":      types.StringType,
+			"label":          types.StringType,
+			"type":           types.StringType,
+			"help_text":      types.StringType,
+			"unit":           types.StringType,
+			"privacy":        types.StringType,
+			"default_value":  types.StringType,
+			"order":          types.Int64Type,
+			"advanced":       types.BoolType,
+		})
+}
+
+// ImplementationSchemaSelectOption is part of ImplementationSchemaField.
+type ImplementationSchemaSelectOption struct {
+	Name  types.String `tfsdk:"name"`
+	Hint  types.String `tfsdk:"hint"`
+	Value types.Int64  `tfsdk:"value"`
+}
+
+func (o ImplementationSchemaSelectOption) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"name":  types.StringType,
+			"hint":  types.StringType,
+			"value": types.Int64Type,
+		})
+}
+
+// implementationSchemaDataSourceSchema returns the common schema of the implementation schema data sources.
+func implementationSchemaDataSourceSchema(subcategory, kind, resource string) schema.Schema {
+	return schema.Schema{
+		// This description is used by the documentation generator and the delay server.
+		MarkdownDescription: "<!-- subcategory:" + subcategory + " -->\nList all available " + kind + " implementations with their fields definition. Fields not mapped to any [sonarr_" + resource + "](../resources/" + resource + ") attribute can be managed via its `extra_fields` attribute.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"implementation": schema.StringAttribute{
+				MarkdownDescription: "Implementation name filter.",
+				Optional:            true,
+			},
+			"schemas": schema.SetNestedAttribute{
+				MarkdownDescription: "Implementation schema list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"implementation": schema.StringAttribute{
+							MarkdownDescription: "Implementation name.",
+							Computed:            true,
+						},
+						"implementation_name": schema.StringAttribute{
+							MarkdownDescription: "Implementation display name.",
+							Computed:            true,
+						},
+						"config_contract": schema.StringAttribute{
+							MarkdownDescription: "Configuration template.",
+							Computed:            true,
+						},
+						"info_link": schema.StringAttribute{
+							MarkdownDescription: "Documentation link.",
+							Computed:            true,
+						},
+						"fields": schema.ListNestedAttribute{
+							MarkdownDescription: "Field list, sorted by order.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Field name.",
+										Computed:            true,
+									},
+									"attribute": schema.StringAttribute{
+										MarkdownDescription: "Attribute of the generic resource managing the field. Null if the field must be managed via `extra_fields`.",
+										Computed:            true,
+									},
+									"label": schema.StringAttribute{
+										MarkdownDescription: "Field label.",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "Field type.",
+										Computed:            true,
+									},
+									"help_text": schema.StringAttribute{
+										MarkdownDescription: "Help text.",
+										Computed:            true,
+									},
+									"unit": schema.StringAttribute{
+										MarkdownDescription: "Unit.",
+										Computed:            true,
+									},
+									"privacy": schema.StringAttribute{
+										MarkdownDescription: "Privacy level. `normal`, `password`, `apiKey` or `userName`.",
+										Computed:            true,
+									},
+									"default_value": schema.StringAttribute{
+										MarkdownDescription: "Default value as JSON.",
+										Computed:            true,
+									},
+									"order": schema.Int64Attribute{
+										MarkdownDescription: "Order.",
+										Computed:            true,
+									},
+									"advanced": schema.BoolAttribute{
+										MarkdownDescription: "Advanced flag.",
+										Computed:            true,
+									},
+									"select_options": schema.ListNestedAttribute{
+										MarkdownDescription: "Select options.",
+										Computed:            true,
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"name": schema.StringAttribute{
+													MarkdownDescription: "Option name.",
+													Computed:            true,
+												},
+												"hint": schema.StringAttribute{
+													MarkdownDescription: "Option hint.",
+													Computed:            true,
+												},
+												"value": schema.Int64Attribute{
+													MarkdownDescription: "Option value.",
+													Computed:            true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *ImplementationSchemas) write(ctx context.Context, implementations []implementationSchemaResource, fieldContainer interface{}, fieldLists helpers.Fields, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	schemas := make([]ImplementationSchema, 0, len(implementations))
+
+	for _, implementation := range implementations {
+		if !s.Implementation.IsNull() && s.Implementation.ValueString() != implementation.GetImplementation() {
+			continue
+		}
+
+		implementationSchema := ImplementationSchema{}
+		implementationSchema.write(ctx, implementation, fieldContainer, fieldLists, diags)
+		schemas = append(schemas, implementationSchema)
+	}
+
+	s.Schemas, localDiag = types.SetValueFrom(ctx, ImplementationSchema{}.getType(), schemas)
+	diags.Append(localDiag...)
+
+	s.ID = types.StringValue(strconv.Itoa(len(schemas)))
+}
+
+func (s *ImplementationSchema) write(ctx context.Context, implementation implementationSchemaResource, fieldContainer interface{}, fieldLists helpers.Fields, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	s.Implementation = types.StringValue(implementation.GetImplementation())
+	s.ImplementationName = types.StringValue(implementation.GetImplementationName())
+	s.ConfigContract = types.StringValue(implementation.GetConfigContract())
+	s.InfoLink = types.StringValue(implementation.GetInfoLink())
+
+	apiFields := implementation.GetFields()
+	slices.SortStableFunc(apiFields, func(a, b sonarr.Field) int { return int(a.GetOrder() - b.GetOrder()) })
+
+	fields := make([]ImplementationSchemaField, len(apiFields))
+	for i, f := range apiFields {
+		fields[i].write(ctx, &f, fieldContainer, fieldLists, diags)
+	}
+
+	s.Fields, localDiag = types.ListValueFrom(ctx, ImplementationSchemaField{}.getType(), fields)
+	diags.Append(localDiag...)
+}
+
+func (f *ImplementationSchemaField) write(ctx context.Context, field *sonarr.Field, fieldContainer interface{}, fieldLists helpers.Fields, diags *diag.Diagnostics) {
+	var localDiag diag.Diagnostics
+
+	f.Name = types.StringValue(field.GetName())
+	f.Label = types.StringValue(field.GetLabel())
+	f.Type = types.StringValue(field.GetType())
+	f.HelpText = types.StringValue(field.GetHelpText())
+	f.Unit = types.StringValue(field.GetUnit())
+	f.Privacy = types.StringValue(string(field.GetPrivacy()))
+	f.Order = types.Int64Value(int64(field.GetOrder()))
+	f.Advanced = types.BoolValue(field.GetAdvanced())
+
+	f.Attribute = types.StringNull()
+	if attribute := helpers.AttributeName(field.GetName(), fieldContainer, fieldLists); attribute != "" {
+		f.Attribute = types.StringValue(attribute)
+	}
+
+	f.DefaultValue = types.StringNull()
+	if value, err := json.Marshal(field.GetValue()); err == nil && field.GetValue() != nil {
+		f.DefaultValue = types.StringValue(string(value))
+	}
+
+	options := make([]ImplementationSchemaSelectOption, len(field.GetSelectOptions()))
+	for i, o := range field.GetSelectOptions() {
+		options[i].Name = types.StringValue(o.GetName())
+		options[i].Hint = types.StringValue(o.GetHint())
+		options[i].Value = types.Int64Value(int64(o.GetValue()))
+	}
+
+	f.SelectOptions, localDiag = types.ListValueFrom(ctx, ImplementationSchemaSelectOption{}.getType(), options)
+	diags.Append(localDiag...)
+}
@@ -0,0 +1,405 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	importListAniListResourceName   = "import_list_anilist"
+	importListAniListImplementation = "AniListImport"
+	importListAniListConfigContract = "AniListSettings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ImportListAniListResource{}
+	_ resource.ResourceWithImportState = &ImportListAniListResource{}
+)
+
+func NewImportListAniListResource() resource.Resource {
+	return &ImportListAniListResource{}
+}
+
+// ImportListAniListResource defines the import list implementation.
+type ImportListAniListResource struct {
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
+}
+
+// ImportListAniList describes the import list data model.
+type ImportListAniList struct {
+	Tags               types.Set    `tfsdk:"tags"`
+	Name               types.String `tfsdk:"name"`
+	ShouldMonitor      types.String `tfsdk:"should_monitor"`
+	RootFolderPath     types.String `tfsdk:"root_folder_path"`
+	SeriesType         types.String `tfsdk:"series_type"`
+	Username           types.String `tfsdk:"username"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	RefreshToken       types.String `tfsdk:"refresh_token"`
+	Expires            types.String `tfsdk:"expires"`
+	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
+	ID                 types.Int64  `tfsdk:"id"`
+	SecretsRevision    types.Int64  `tfsdk:"secrets_revision"`
+	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	ImportCurrent      types.Bool   `tfsdk:"import_current"`
+	ImportPlanning     types.Bool   `tfsdk:"import_planning"`
+	ImportCompleted    types.Bool   `tfsdk:"import_completed"`
+	ImportDropped      types.Bool   `tfsdk:"import_dropped"`
+	ImportPaused       types.Bool   `tfsdk:"import_paused"`
+	ImportRepeating    types.Bool   `tfsdk:"import_repeating"`
+	ImportFinished     types.Bool   `tfsdk:"import_finished"`
+	ImportReleasing    types.Bool   `tfsdk:"import_releasing"`
+	ImportUnreleased   types.Bool   `tfsdk:"import_unreleased"`
+	ImportCancelled    types.Bool   `tfsdk:"import_cancelled"`
+	ImportHiatus       types.Bool   `tfsdk:"import_hiatus"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i ImportListAniList) toImportList() *ImportList {
+	return &ImportList{
+		Tags:               i.Tags,
+		Name:               i.Name,
+		ShouldMonitor:      i.ShouldMonitor,
+		RootFolderPath:     i.RootFolderPath,
+		SeriesType:         i.SeriesType,
+		Username:           i.Username,
+		AccessToken:        i.AccessToken,
+		RefreshToken:       i.RefreshToken,
+		Expires:            i.Expires,
+		QualityProfileID:   i.QualityProfileID,
+		ID:                 i.ID,
+		EnableAutomaticAdd: i.EnableAutomaticAdd,
+		SeasonFolder:       i.SeasonFolder,
+		ImportCurrent:      i.ImportCurrent,
+		ImportPlanning:     i.ImportPlanning,
+		ImportCompleted:    i.ImportCompleted,
+		ImportDropped:      i.ImportDropped,
+		ImportPaused:       i.ImportPaused,
+		ImportRepeating:    i.ImportRepeating,
+		ImportFinished:     i.ImportFinished,
+		ImportReleasing:    i.ImportReleasing,
+		ImportUnreleased:   i.ImportUnreleased,
+		ImportCancelled:    i.ImportCancelled,
+		ImportHiatus:       i.ImportHiatus,
+		ConfigContract:     types.StringValue(importListAniListConfigContract),
+		Implementation:     types.StringValue(importListAniListImplementation),
+	}
+}
+
+func (i *ImportListAniList) fromImportList(importList *ImportList) {
+	i.Tags = importList.Tags
+	i.Name = importList.Name
+	i.ShouldMonitor = importList.ShouldMonitor
+	i.RootFolderPath = importList.RootFolderPath
+	i.SeriesType = importList.SeriesType
+	i.Username = importList.Username
+	i.AccessToken = importList.AccessToken
+	i.RefreshToken = importList.RefreshToken
+	i.Expires = importList.Expires
+	i.QualityProfileID = importList.QualityProfileID
+	i.ID = importList.ID
+	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
+	i.SeasonFolder = importList.SeasonFolder
+	i.ImportCurrent = importList.ImportCurrent
+	i.ImportPlanning = importList.ImportPlanning
+	i.ImportCompleted = importList.ImportCompleted
+	i.ImportDropped = importList.ImportDropped
+	i.ImportPaused = importList.ImportPaused
+	i.ImportRepeating = importList.ImportRepeating
+	i.ImportFinished = importList.ImportFinished
+	i.ImportReleasing = importList.ImportReleasing
+	i.ImportUnreleased = importList.ImportUnreleased
+	i.ImportCancelled = importList.ImportCancelled
+	i.ImportHiatus = importList.ImportHiatus
+}
+
+func (r *ImportListAniListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + importListAniListResourceName
+}
+
+func (r *ImportListAniListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList AniList resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [AniList](https://wiki.servarr.com/sonarr/supported#anilistimport).",
+		Attributes: map[string]schema.Attribute{
+			"enable_automatic_add": schema.BoolAttribute{
+				MarkdownDescription: "Enable automatic add flag.",
+				Required:            true,
+			},
+			"season_folder": schema.BoolAttribute{
+				MarkdownDescription: "Season folder flag.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"should_monitor": schema.StringAttribute{
+				MarkdownDescription: "Should monitor.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "future", "missing", "existing", "pilot", "firstSeason", "latestSeason", "none"),
+				},
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Required:            true,
+			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "anime", "daily"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Import List name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Import List ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"username": schema.StringAttribute{
+				MarkdownDescription: "AniList username.",
+				Required:            true,
+			},
+			"import_current": schema.BoolAttribute{
+				MarkdownDescription: "Import watching (current) entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_planning": schema.BoolAttribute{
+				MarkdownDescription: "Import planning entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_completed": schema.BoolAttribute{
+				MarkdownDescription: "Import completed entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_dropped": schema.BoolAttribute{
+				MarkdownDescription: "Import dropped entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_paused": schema.BoolAttribute{
+				MarkdownDescription: "Import paused entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_repeating": schema.BoolAttribute{
+				MarkdownDescription: "Import repeating entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_finished": schema.BoolAttribute{
+				MarkdownDescription: "Import finished media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_releasing": schema.BoolAttribute{
+				MarkdownDescription: "Import releasing media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_unreleased": schema.BoolAttribute{
+				MarkdownDescription: "Import not yet released media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_cancelled": schema.BoolAttribute{
+				MarkdownDescription: "Import cancelled media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_hiatus": schema.BoolAttribute{
+				MarkdownDescription: "Import media on hiatus.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token. Its rotation by Sonarr is not reported as drift.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "Refresh token.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ImportListAniListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
+	}
+}
+
+func (r *ImportListAniListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var importList *ImportListAniList
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new ImportListAniList
+	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListAniListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListAniListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+importListAniListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListAniListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var importList *ImportListAniList
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get ImportListAniList current value
+	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListAniListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+importListAniListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListAniListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var importList, state *ImportListAniList
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update ImportListAniList
+	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListAniListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListAniListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+importListAniListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListAniListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete ImportListAniList current value
+	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListAniListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+importListAniListResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ImportListAniListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListAniListResourceName, findImportListIDs(r.auth, r.client, importListAniListImplementation))
+	tflog.Trace(ctx, "imported "+importListAniListResourceName+": "+req.ID)
+}
+
+func (i *ImportListAniList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
+	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
+	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
+	i.fromImportList(genericImportList)
+}
+
+func (i *ImportListAniList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.ImportListResource {
+	return i.toImportList().read(ctx, diags)
+}
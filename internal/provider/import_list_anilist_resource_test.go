@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImportListAniListResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccImportListAniListResourceConfig("resourceAniListTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccImportListAniListResourceConfig("resourceAniListTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_anilist.test", "season_folder", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_import_list_anilist.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccImportListAniListResourceConfig("resourceAniListTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccImportListAniListResourceConfig("resourceAniListTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_anilist.test", "season_folder", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_import_list_anilist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccImportListAniListResourceConfig(name, folder string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_import_list_anilist" "test" {
+		enable_automatic_add = false
+		season_folder = %s
+		should_monitor = "all"
+		series_type = "standard"
+		root_folder_path = "/config"
+		quality_profile_id = 1
+		name = "%s"
+		username = "user"
+		access_token = "Token"
+		import_current = true
+		import_planning = true
+		tags = []
+	}`, folder, name)
+}
@@ -36,8 +36,9 @@ func NewImportListCustomResource() resource.Resource {
 
 // ImportListCustomResource defines the import list implementation.
 type ImportListCustomResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListCustom describes the import list data model.
@@ -52,6 +53,7 @@ type ImportListCustom struct {
 	ID                 types.Int64  `tfsdk:"id"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListCustom) toImportList() *ImportList {
@@ -132,6 +134,7 @@ func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -143,15 +146,19 @@ func (r *ImportListCustomResource) Schema(_ context.Context, _ resource.SchemaRe
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 		},
 	}
 }
 
 func (r *ImportListCustomResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -168,6 +175,10 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListCustomResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCustomResourceName, err))
@@ -218,6 +229,10 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListCustomResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCustomResourceName, err))
@@ -253,7 +268,7 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListCustomResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListCustomResourceName, findImportListIDs(r.auth, r.client, importListCustomImplementation))
 	tflog.Trace(ctx, "imported "+importListCustomResourceName+": "+req.ID)
 }
 
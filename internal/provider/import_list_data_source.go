@@ -36,6 +36,18 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nSingle [Import Lists](../resources/import_list).",
 		Attributes: map[string]schema.Attribute{
+			"secrets_revision": schema.Int64Attribute{
+				MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+				Computed:            true,
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Computed:            true,
@@ -94,6 +106,54 @@ func (d *ImportListDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 				MarkdownDescription: "Simkl list type.",
 				Computed:            true,
 			},
+			"list_status": schema.Int64Attribute{
+				MarkdownDescription: "MyAnimeList list status.",
+				Computed:            true,
+			},
+			"import_current": schema.BoolAttribute{
+				MarkdownDescription: "Import watching (current) AniList entries.",
+				Computed:            true,
+			},
+			"import_planning": schema.BoolAttribute{
+				MarkdownDescription: "Import planning AniList entries.",
+				Computed:            true,
+			},
+			"import_completed": schema.BoolAttribute{
+				MarkdownDescription: "Import completed AniList entries.",
+				Computed:            true,
+			},
+			"import_dropped": schema.BoolAttribute{
+				MarkdownDescription: "Import dropped AniList entries.",
+				Computed:            true,
+			},
+			"import_paused": schema.BoolAttribute{
+				MarkdownDescription: "Import paused AniList entries.",
+				Computed:            true,
+			},
+			"import_repeating": schema.BoolAttribute{
+				MarkdownDescription: "Import repeating AniList entries.",
+				Computed:            true,
+			},
+			"import_finished": schema.BoolAttribute{
+				MarkdownDescription: "Import finished AniList media.",
+				Computed:            true,
+			},
+			"import_releasing": schema.BoolAttribute{
+				MarkdownDescription: "Import releasing AniList media.",
+				Computed:            true,
+			},
+			"import_unreleased": schema.BoolAttribute{
+				MarkdownDescription: "Import not yet released AniList media.",
+				Computed:            true,
+			},
+			"import_cancelled": schema.BoolAttribute{
+				MarkdownDescription: "Import cancelled AniList media.",
+				Computed:            true,
+			},
+			"import_hiatus": schema.BoolAttribute{
+				MarkdownDescription: "Import AniList media on hiatus.",
+				Computed:            true,
+			},
 			"access_token": schema.StringAttribute{
 				MarkdownDescription: "Access token.",
 				Computed:            true,
@@ -36,8 +36,9 @@ func NewImportListImdbResource() resource.Resource {
 
 // ImportListImdbResource defines the import list implementation.
 type ImportListImdbResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListImdb describes the import list data model.
@@ -52,6 +53,7 @@ type ImportListImdb struct {
 	ID                 types.Int64  `tfsdk:"id"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListImdb) toImportList() *ImportList {
@@ -132,6 +134,7 @@ func (r *ImportListImdbResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -149,9 +152,10 @@ func (r *ImportListImdbResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *ImportListImdbResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -168,6 +172,10 @@ func (r *ImportListImdbResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListImdbResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListImdbResourceName, err))
@@ -218,6 +226,10 @@ func (r *ImportListImdbResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListImdbResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListImdbResourceName, err))
@@ -253,7 +265,7 @@ func (r *ImportListImdbResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListImdbResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListImdbResourceName, findImportListIDs(r.auth, r.client, importListImdbImplementation))
 	tflog.Trace(ctx, "imported "+importListImdbResourceName+": "+req.ID)
 }
 
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	importListMyAnimeListResourceName   = "import_list_myanimelist"
+	importListMyAnimeListImplementation = "MyAnimeListImport"
+	importListMyAnimeListConfigContract = "MyAnimeListSettings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ImportListMyAnimeListResource{}
+	_ resource.ResourceWithImportState = &ImportListMyAnimeListResource{}
+)
+
+func NewImportListMyAnimeListResource() resource.Resource {
+	return &ImportListMyAnimeListResource{}
+}
+
+// ImportListMyAnimeListResource defines the import list implementation.
+type ImportListMyAnimeListResource struct {
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
+}
+
+// ImportListMyAnimeList describes the import list data model.
+type ImportListMyAnimeList struct {
+	Tags               types.Set    `tfsdk:"tags"`
+	Name               types.String `tfsdk:"name"`
+	ShouldMonitor      types.String `tfsdk:"should_monitor"`
+	RootFolderPath     types.String `tfsdk:"root_folder_path"`
+	SeriesType         types.String `tfsdk:"series_type"`
+	AccessToken        types.String `tfsdk:"access_token"`
+	RefreshToken       types.String `tfsdk:"refresh_token"`
+	Expires            types.String `tfsdk:"expires"`
+	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
+	ID                 types.Int64  `tfsdk:"id"`
+	ListStatus         types.Int64  `tfsdk:"list_status"`
+	SecretsRevision    types.Int64  `tfsdk:"secrets_revision"`
+	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
+	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (i ImportListMyAnimeList) toImportList() *ImportList {
+	return &ImportList{
+		Tags:               i.Tags,
+		Name:               i.Name,
+		ShouldMonitor:      i.ShouldMonitor,
+		RootFolderPath:     i.RootFolderPath,
+		SeriesType:         i.SeriesType,
+		AccessToken:        i.AccessToken,
+		RefreshToken:       i.RefreshToken,
+		Expires:            i.Expires,
+		QualityProfileID:   i.QualityProfileID,
+		ID:                 i.ID,
+		ListStatus:         i.ListStatus,
+		EnableAutomaticAdd: i.EnableAutomaticAdd,
+		SeasonFolder:       i.SeasonFolder,
+		ConfigContract:     types.StringValue(importListMyAnimeListConfigContract),
+		Implementation:     types.StringValue(importListMyAnimeListImplementation),
+	}
+}
+
+func (i *ImportListMyAnimeList) fromImportList(importList *ImportList) {
+	i.Tags = importList.Tags
+	i.Name = importList.Name
+	i.ShouldMonitor = importList.ShouldMonitor
+	i.RootFolderPath = importList.RootFolderPath
+	i.SeriesType = importList.SeriesType
+	i.AccessToken = importList.AccessToken
+	i.RefreshToken = importList.RefreshToken
+	i.Expires = importList.Expires
+	i.QualityProfileID = importList.QualityProfileID
+	i.ID = importList.ID
+	i.ListStatus = importList.ListStatus
+	i.EnableAutomaticAdd = importList.EnableAutomaticAdd
+	i.SeasonFolder = importList.SeasonFolder
+}
+
+func (r *ImportListMyAnimeListResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + importListMyAnimeListResourceName
+}
+
+func (r *ImportListMyAnimeListResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Import Lists -->\nImportList MyAnimeList resource.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists) and [MyAnimeList](https://wiki.servarr.com/sonarr/supported#myanimelistimport).",
+		Attributes: map[string]schema.Attribute{
+			"enable_automatic_add": schema.BoolAttribute{
+				MarkdownDescription: "Enable automatic add flag.",
+				Required:            true,
+			},
+			"season_folder": schema.BoolAttribute{
+				MarkdownDescription: "Season folder flag.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality profile ID.",
+				Required:            true,
+			},
+			"should_monitor": schema.StringAttribute{
+				MarkdownDescription: "Should monitor.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "future", "missing", "existing", "pilot", "firstSeason", "latestSeason", "none"),
+				},
+			},
+			"root_folder_path": schema.StringAttribute{
+				MarkdownDescription: "Root folder path.",
+				Required:            true,
+			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "anime", "daily"),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Import List name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Import List ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"list_status": schema.Int64Attribute{
+				MarkdownDescription: "List status. `0` All, `1` Watching, `2` Completed, `3` OnHold, `4` Dropped, `5` PlanToWatch.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(0, 1, 2, 3, 4, 5),
+				},
+			},
+			"access_token": schema.StringAttribute{
+				MarkdownDescription: "Access token. Its rotation by Sonarr is not reported as drift.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "Refresh token.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires": schema.StringAttribute{
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ImportListMyAnimeListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
+	}
+}
+
+func (r *ImportListMyAnimeListResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var importList *ImportListMyAnimeList
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new ImportListMyAnimeList
+	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListMyAnimeListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListMyAnimeListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+importListMyAnimeListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListMyAnimeListResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var importList *ImportListMyAnimeList
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &importList)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get ImportListMyAnimeList current value
+	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListMyAnimeListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+importListMyAnimeListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListMyAnimeListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var importList, state *ImportListMyAnimeList
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update ImportListMyAnimeList
+	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListMyAnimeListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListMyAnimeListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+importListMyAnimeListResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	importList.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &importList)...)
+}
+
+func (r *ImportListMyAnimeListResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete ImportListMyAnimeList current value
+	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListMyAnimeListResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+importListMyAnimeListResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *ImportListMyAnimeListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListMyAnimeListResourceName, findImportListIDs(r.auth, r.client, importListMyAnimeListImplementation))
+	tflog.Trace(ctx, "imported "+importListMyAnimeListResourceName+": "+req.ID)
+}
+
+func (i *ImportListMyAnimeList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
+	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
+	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
+	i.fromImportList(genericImportList)
+}
+
+func (i *ImportListMyAnimeList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.ImportListResource {
+	return i.toImportList().read(ctx, diags)
+}
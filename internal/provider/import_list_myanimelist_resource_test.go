@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImportListMyAnimeListResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccImportListMyAnimeListResourceConfig("resourceMyAnimeListTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				PreConfig: rootFolderDSInit,
+				Config:    testAccImportListMyAnimeListResourceConfig("resourceMyAnimeListTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_myanimelist.test", "season_folder", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_import_list_myanimelist.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccImportListMyAnimeListResourceConfig("resourceMyAnimeListTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccImportListMyAnimeListResourceConfig("resourceMyAnimeListTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_import_list_myanimelist.test", "season_folder", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_import_list_myanimelist.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccImportListMyAnimeListResourceConfig(name, folder string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_import_list_myanimelist" "test" {
+		enable_automatic_add = false
+		season_folder = %s
+		should_monitor = "all"
+		series_type = "standard"
+		root_folder_path = "/config"
+		quality_profile_id = 1
+		name = "%s"
+		access_token = "Token"
+		list_status = 1
+		tags = []
+	}`, folder, name)
+}
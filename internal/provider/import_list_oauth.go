@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	importListAccessTokenField  = "accessToken"
+	importListRefreshTokenField = "refreshToken"
+	importListExpiresField      = "expires"
+	// importListTokenRenewMargin anticipates the renewal, as Sonarr does, to avoid tokens expiring mid request.
+	importListTokenRenewMargin = 5 * time.Minute
+	// importListTokenRenewTimeout bounds the OAuth proxy call, so that an unresponsive proxy cannot hang the apply.
+	importListTokenRenewTimeout = 30 * time.Second
+)
+
+var (
+	errImportListRenewStatus      = errors.New("unexpected status")
+	errImportListRenewAccessToken = errors.New("empty access token")
+)
+
+// importListRenewURLs are the Sonarr OAuth proxy endpoints renewing the import list tokens, by implementation.
+var importListRenewURLs = map[string]string{
+	importListTraktListImplementation:    "https://auth.servarr.com/v1/trakt/renew",
+	importListTraktPopularImplementation: "https://auth.servarr.com/v1/trakt/renew",
+	importListTraktUserImplementation:    "https://auth.servarr.com/v1/trakt/renew",
+	importListSimklUserImplementation:    "https://auth.servarr.com/v1/simkl/renew",
+}
+
+// importListRenewClient is the HTTP client used to reach the OAuth proxy, outside of the Sonarr API client.
+var importListRenewClient = &http.Client{Timeout: importListTokenRenewTimeout}
+
+// importListTokens are the OAuth attributes of the import list, rotated by Sonarr on its own.
+type importListTokens struct {
+	AccessToken  types.String
+	RefreshToken types.String
+	Expires      types.String
+}
+
+// importListRenewResponse is the OAuth proxy renewal response.
+type importListRenewResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	ExpiresIn    int64  `json:"expires_in"`
+}
+
+func (i *ImportList) tokens() importListTokens {
+	return importListTokens{
+		AccessToken:  i.AccessToken,
+		RefreshToken: i.RefreshToken,
+		Expires:      i.Expires,
+	}
+}
+
+// keep restores the known tokens on the import list, so that the rotation done by Sonarr is not reported as drift.
+func (t importListTokens) keep(importList *ImportList) {
+	if !t.AccessToken.IsNull() && !t.AccessToken.IsUnknown() {
+		importList.AccessToken = t.AccessToken
+	}
+
+	if !t.RefreshToken.IsNull() && !t.RefreshToken.IsUnknown() {
+		importList.RefreshToken = t.RefreshToken
+	}
+
+	if !t.Expires.IsNull() && !t.Expires.IsUnknown() {
+		importList.Expires = t.Expires
+	}
+}
+
+// unchanged checks if the planned tokens are the same of the state ones.
+func (t importListTokens) unchanged(state importListTokens) bool {
+	isUnchanged := func(plan, state types.String) bool {
+		return plan.IsUnknown() || plan.Equal(state)
+	}
+
+	return isUnchanged(t.AccessToken, state.AccessToken) &&
+		isUnchanged(t.RefreshToken, state.RefreshToken) &&
+		isUnchanged(t.Expires, state.Expires)
+}
+
+// prepareImportListTokens sends back to Sonarr its own rotated tokens, unless they were changed in configuration,
+// and renews them when expired. State is nil on creation.
+func prepareImportListTokens(ctx context.Context, client *sonarr.APIClient, auth context.Context, request *sonarr.ImportListResource, plan, state *ImportList, diags *diag.Diagnostics) {
+	if state != nil && plan.tokens().unchanged(state.tokens()) {
+		current, _, err := client.ImportListAPI.GetImportListById(auth, request.GetId()).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
+
+			return
+		}
+
+		for _, name := range []string{importListAccessTokenField, importListRefreshTokenField, importListExpiresField} {
+			if value, ok := importListField(current.GetFields(), name); ok {
+				setImportListField(request, name, value)
+			}
+		}
+	}
+
+	renewURL, ok := importListRenewURLs[request.GetImplementation()]
+	if !ok {
+		return
+	}
+
+	if err := renewImportListTokens(ctx, importListRenewClient, renewURL, request, time.Now()); err != nil {
+		diags.AddWarning(helpers.ResourceError, fmt.Sprintf("Unable to renew %s tokens, got error: %s", request.GetImplementation(), err))
+	}
+}
+
+// renewImportListTokens refreshes the expired tokens of the request through the OAuth proxy.
+func renewImportListTokens(ctx context.Context, client *http.Client, renewURL string, request *sonarr.ImportListResource, now time.Time) error {
+	refreshToken, _ := importListField(request.GetFields(), importListRefreshTokenField)
+	if refreshToken == "" || !importListTokensExpired(request.GetFields(), now) {
+		return nil
+	}
+
+	endpoint, err := url.Parse(renewURL)
+	if err != nil {
+		return err
+	}
+
+	endpoint.RawQuery = url.Values{"refresh": []string{refreshToken}}.Encode()
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return err
+	}
+
+	httpResponse, err := client.Do(httpRequest)
+	if err != nil {
+		return err
+	}
+	defer httpResponse.Body.Close()
+
+	if httpResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%w %s", errImportListRenewStatus, httpResponse.Status)
+	}
+
+	var token importListRenewResponse
+	if err := json.NewDecoder(httpResponse.Body).Decode(&token); err != nil {
+		return err
+	}
+
+	if token.AccessToken == "" {
+		return errImportListRenewAccessToken
+	}
+
+	// the proxy omits the refresh token when it is not rotated
+	if token.RefreshToken == "" {
+		token.RefreshToken = refreshToken
+	}
+
+	setImportListField(request, importListAccessTokenField, token.AccessToken)
+	setImportListField(request, importListRefreshTokenField, token.RefreshToken)
+	setImportListField(request, importListExpiresField, now.UTC().Add(time.Duration(token.ExpiresIn)*time.Second).Format(time.RFC3339))
+	tflog.Trace(ctx, "renewed "+request.GetImplementation()+" tokens")
+
+	return nil
+}
+
+// importListTokensExpired checks if the access token is missing or about to expire.
+func importListTokensExpired(fields []sonarr.Field, now time.Time) bool {
+	accessToken, _ := importListField(fields, importListAccessTokenField)
+	expires, _ := importListField(fields, importListExpiresField)
+
+	expiration, err := time.Parse(time.RFC3339, expires)
+	if err != nil {
+		// Sonarr may serialize dates without time zone, always in UTC
+		expiration, err = time.Parse("2006-01-02T15:04:05", expires)
+	}
+
+	return accessToken == "" || err == nil && expiration.Before(now.Add(importListTokenRenewMargin))
+}
+
+// importListField returns the string value of the named field.
+func importListField(fields []sonarr.Field, name string) (string, bool) {
+	for _, f := range fields {
+		if f.GetName() == name {
+			value, ok := f.GetValue().(string)
+
+			return value, ok
+		}
+	}
+
+	return "", false
+}
+
+// setImportListField sets the value of the named field, adding it if missing.
+func setImportListField(request *sonarr.ImportListResource, name, value string) {
+	for i := range request.Fields {
+		if request.Fields[i].GetName() == name {
+			request.Fields[i].SetValue(value)
+
+			return
+		}
+	}
+
+	field := sonarr.NewField()
+	field.SetName(name)
+	field.SetValue(value)
+	request.Fields = append(request.Fields, *field)
+}
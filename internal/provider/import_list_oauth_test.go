@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func testImportListTokensRequest(accessToken, refreshToken, expires string) *sonarr.ImportListResource {
+	request := sonarr.NewImportListResource()
+	setImportListField(request, importListAccessTokenField, accessToken)
+	setImportListField(request, importListRefreshTokenField, refreshToken)
+	setImportListField(request, importListExpiresField, expires)
+
+	return request
+}
+
+func TestRenewImportListTokens(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		request  *sonarr.ImportListResource
+		response string
+		status   int
+		expected []string
+		renewed  bool
+		err      bool
+	}{
+		"valid": {
+			request:  testImportListTokensRequest("access", "refresh", "2024-01-02T12:00:00Z"),
+			expected: []string{"access", "refresh", "2024-01-02T12:00:00Z"},
+		},
+		"expired": {
+			request:  testImportListTokensRequest("access", "refresh", "2024-01-01T11:00:00Z"),
+			response: `{"access_token":"new_access","refresh_token":"new_refresh","expires_in":3600}`,
+			status:   http.StatusOK,
+			expected: []string{"new_access", "new_refresh", "2024-01-01T13:00:00Z"},
+			renewed:  true,
+		},
+		"expiring without time zone": {
+			request:  testImportListTokensRequest("access", "refresh", "2024-01-01T12:01:00"),
+			response: `{"access_token":"new_access","expires_in":60}`,
+			status:   http.StatusOK,
+			expected: []string{"new_access", "refresh", "2024-01-01T12:01:00Z"},
+			renewed:  true,
+		},
+		"missing access token": {
+			request:  testImportListTokensRequest("", "refresh", ""),
+			response: `{"access_token":"new_access","refresh_token":"new_refresh","expires_in":60}`,
+			status:   http.StatusOK,
+			expected: []string{"new_access", "new_refresh", "2024-01-01T12:01:00Z"},
+			renewed:  true,
+		},
+		"missing refresh token": {
+			request:  testImportListTokensRequest("access", "", "2024-01-01T11:00:00Z"),
+			expected: []string{"access", "", "2024-01-01T11:00:00Z"},
+		},
+		"proxy error": {
+			request:  testImportListTokensRequest("access", "refresh", "2024-01-01T11:00:00Z"),
+			status:   http.StatusBadRequest,
+			expected: []string{"access", "refresh", "2024-01-01T11:00:00Z"},
+			renewed:  true,
+			err:      true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			renewed := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				renewed = true
+
+				assert.Equal(t, "refresh", r.URL.Query().Get("refresh"))
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.response))
+			}))
+			defer server.Close()
+
+			err := renewImportListTokens(context.Background(), server.Client(), server.URL, test.request, now)
+			assert.Equal(t, test.err, err != nil)
+			assert.Equal(t, test.renewed, renewed)
+
+			for i, name := range []string{importListAccessTokenField, importListRefreshTokenField, importListExpiresField} {
+				value, _ := importListField(test.request.GetFields(), name)
+				assert.Equal(t, test.expected[i], value)
+			}
+		})
+	}
+}
+
+func TestImportListTokens(t *testing.T) {
+	t.Parallel()
+
+	state := &ImportList{
+		AccessToken:  types.StringValue("access"),
+		RefreshToken: types.StringValue("refresh"),
+		Expires:      types.StringValue("2024-01-01T12:00:00Z"),
+	}
+
+	tests := map[string]struct {
+		plan      importListTokens
+		unchanged bool
+	}{
+		"unchanged": {
+			plan:      state.tokens(),
+			unchanged: true,
+		},
+		"unknown": {
+			plan:      importListTokens{AccessToken: types.StringUnknown(), RefreshToken: types.StringUnknown(), Expires: types.StringUnknown()},
+			unchanged: true,
+		},
+		"changed": {
+			plan: importListTokens{AccessToken: types.StringValue("new_access"), RefreshToken: state.RefreshToken, Expires: state.Expires},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.unchanged, test.plan.unchanged(state.tokens()))
+		})
+	}
+
+	// rotated tokens written by Sonarr are replaced by the known ones
+	importList := &ImportList{
+		AccessToken:  types.StringValue("rotated_access"),
+		RefreshToken: types.StringValue("rotated_refresh"),
+		Expires:      types.StringValue("2024-02-01T12:00:00Z"),
+	}
+	importListTokens{AccessToken: state.AccessToken, RefreshToken: types.StringNull(), Expires: types.StringUnknown()}.keep(importList)
+	assert.Equal(t, "access", importList.AccessToken.ValueString())
+	assert.Equal(t, "rotated_refresh", importList.RefreshToken.ValueString())
+	assert.Equal(t, "2024-02-01T12:00:00Z", importList.Expires.ValueString())
+}
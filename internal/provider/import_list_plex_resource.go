@@ -36,8 +36,9 @@ func NewImportListPlexResource() resource.Resource {
 
 // ImportListPlexResource defines the import list implementation.
 type ImportListPlexResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListPlex describes the import list data model.
@@ -50,8 +51,10 @@ type ImportListPlex struct {
 	AccessToken        types.String `tfsdk:"access_token"`
 	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
 	ID                 types.Int64  `tfsdk:"id"`
+	SecretsRevision    types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListPlex) toImportList() *ImportList {
@@ -132,6 +135,8 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -150,9 +155,10 @@ func (r *ImportListPlexResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *ImportListPlexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -169,6 +175,10 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListPlexResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
@@ -219,6 +229,10 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListPlexResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
@@ -254,7 +268,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ImportListPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListPlexResourceName, findImportListIDs(r.auth, r.client, importListPlexImplementation))
 	tflog.Trace(ctx, "imported "+importListPlexResourceName+": "+req.ID)
 }
 
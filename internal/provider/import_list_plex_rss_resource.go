@@ -36,8 +36,9 @@ func NewImportListPlexRSSResource() resource.Resource {
 
 // ImportListPlexRSSResource defines the import list implementation.
 type ImportListPlexRSSResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListPlexRSS describes the import list data model.
@@ -52,6 +53,7 @@ type ImportListPlexRSS struct {
 	ID                 types.Int64  `tfsdk:"id"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListPlexRSS) toImportList() *ImportList {
@@ -132,6 +134,7 @@ func (r *ImportListPlexRSSResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -143,15 +146,19 @@ func (r *ImportListPlexRSSResource) Schema(_ context.Context, _ resource.SchemaR
 			"url": schema.StringAttribute{
 				MarkdownDescription: "URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 		},
 	}
 }
 
 func (r *ImportListPlexRSSResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -168,6 +175,10 @@ func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.Cre
 	// Create new ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListPlexRSSResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexRSSResourceName, err))
@@ -218,6 +229,10 @@ func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.Upd
 	// Update ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListPlexRSSResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexRSSResourceName, err))
@@ -253,7 +268,7 @@ func (r *ImportListPlexRSSResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *ImportListPlexRSSResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListPlexRSSResourceName, findImportListIDs(r.auth, r.client, importListPlexRSSImplementation))
 	tflog.Trace(ctx, "imported "+importListPlexRSSResourceName+": "+req.ID)
 }
 
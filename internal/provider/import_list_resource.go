@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,7 +27,8 @@ var (
 )
 
 var importListFields = helpers.Fields{
-	Ints:      []string{"limit", "traktListType", "listType"},
+	Bools:     []string{"importCurrent", "importPlanning", "importCompleted", "importDropped", "importPaused", "importRepeating", "importFinished", "importReleasing", "importUnreleased", "importCancelled", "importHiatus"},
+	Ints:      []string{"limit", "traktListType", "listType", "listStatus"},
 	Strings:   []string{"accessToken", "baseUrl", "apiKey", "refreshToken", "expires", "authUser", "username", "rating", "listname", "genres", "years", "traktAdditionalParameters", "listId", "url"},
 	IntSlices: []string{"profileIds", "languageProfileIDs", "tagIds"},
 }
@@ -37,8 +39,9 @@ func NewImportListResource() resource.Resource {
 
 // ImportListResource defines the download client implementation.
 type ImportListResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportList describes the download client data model.
@@ -47,6 +50,7 @@ type ImportList struct {
 	LanguageProfileIDs        types.Set    `tfsdk:"language_profile_ids"`
 	ProfileIDs                types.Set    `tfsdk:"quality_profile_ids"`
 	TagIDs                    types.Set    `tfsdk:"tag_ids"`
+	ExtraFields               types.String `tfsdk:"extra_fields"`
 	Implementation            types.String `tfsdk:"implementation"`
 	Name                      types.String `tfsdk:"name"`
 	ShouldMonitor             types.String `tfsdk:"should_monitor"`
@@ -72,13 +76,29 @@ type ImportList struct {
 	Limit                     types.Int64  `tfsdk:"limit"`
 	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
 	ListType                  types.Int64  `tfsdk:"list_type"`
+	ListStatus                types.Int64  `tfsdk:"list_status"`
+	SecretsRevision           types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	ImportCurrent             types.Bool   `tfsdk:"import_current"`
+	ImportPlanning            types.Bool   `tfsdk:"import_planning"`
+	ImportCompleted           types.Bool   `tfsdk:"import_completed"`
+	ImportDropped             types.Bool   `tfsdk:"import_dropped"`
+	ImportPaused              types.Bool   `tfsdk:"import_paused"`
+	ImportRepeating           types.Bool   `tfsdk:"import_repeating"`
+	ImportFinished            types.Bool   `tfsdk:"import_finished"`
+	ImportReleasing           types.Bool   `tfsdk:"import_releasing"`
+	ImportUnreleased          types.Bool   `tfsdk:"import_unreleased"`
+	ImportCancelled           types.Bool   `tfsdk:"import_cancelled"`
+	ImportHiatus              types.Bool   `tfsdk:"import_hiatus"`
+	TestOnApply               types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportList) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
+			"test_on_apply":               types.BoolType,
+			"extra_fields":                types.StringType,
 			"tag_ids":                     types.SetType{}.WithElementType(types.Int64Type),
 			"tags":                        types.SetType{}.WithElementType(types.Int64Type),
 			"language_profile_ids":        types.SetType{}.WithElementType(types.Int64Type),
@@ -108,8 +128,20 @@ func (i ImportList) getType() attr.Type {
 			"limit":                       types.Int64Type,
 			"trakt_list_type":             types.Int64Type,
 			"list_type":                   types.Int64Type,
+			"list_status":                 types.Int64Type,
 			"enable_automatic_add":        types.BoolType,
 			"season_folder":               types.BoolType,
+			"import_current":              types.BoolType,
+			"import_planning":             types.BoolType,
+			"import_completed":            types.BoolType,
+			"import_dropped":              types.BoolType,
+			"import_paused":               types.BoolType,
+			"import_repeating":            types.BoolType,
+			"import_finished":             types.BoolType,
+			"import_releasing":            types.BoolType,
+			"import_unreleased":           types.BoolType,
+			"import_cancelled":            types.BoolType,
+			"import_hiatus":               types.BoolType,
 		})
 }
 
@@ -121,6 +153,14 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Import Lists -->\nGeneric Import List resource. When possible use a specific resource instead.\nFor more information refer to [Import List](https://wiki.servarr.com/sonarr/settings#import-lists).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields as JSON object (e.g. `jsonencode({ newField = true })`), to manage fields not supported by any other attribute. They are merged with the other fields, overriding them. When unset, it shows all the fields returned by Sonarr not supported by any other attribute.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
 			"enable_automatic_add": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic add flag.",
 				Optional:            true,
@@ -169,6 +209,8 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -192,6 +234,66 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Optional:            true,
 				Computed:            true,
 			},
+			"list_status": schema.Int64Attribute{
+				MarkdownDescription: "MyAnimeList list status.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_current": schema.BoolAttribute{
+				MarkdownDescription: "Import watching (current) AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_planning": schema.BoolAttribute{
+				MarkdownDescription: "Import planning AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_completed": schema.BoolAttribute{
+				MarkdownDescription: "Import completed AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_dropped": schema.BoolAttribute{
+				MarkdownDescription: "Import dropped AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_paused": schema.BoolAttribute{
+				MarkdownDescription: "Import paused AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_repeating": schema.BoolAttribute{
+				MarkdownDescription: "Import repeating AniList entries.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_finished": schema.BoolAttribute{
+				MarkdownDescription: "Import finished AniList media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_releasing": schema.BoolAttribute{
+				MarkdownDescription: "Import releasing AniList media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_unreleased": schema.BoolAttribute{
+				MarkdownDescription: "Import not yet released AniList media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_cancelled": schema.BoolAttribute{
+				MarkdownDescription: "Import cancelled AniList media.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"import_hiatus": schema.BoolAttribute{
+				MarkdownDescription: "Import AniList media on hiatus.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"access_token": schema.StringAttribute{
 				MarkdownDescription: "Access token.",
 				Optional:            true,
@@ -229,11 +331,17 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"url": schema.StringAttribute{
 				MarkdownDescription: "URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"expires": schema.StringAttribute{
 				MarkdownDescription: "Expires.",
@@ -288,9 +396,10 @@ func (r *ImportListResource) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *ImportListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -307,6 +416,10 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
@@ -319,6 +432,9 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state ImportList
 
+	state.ExtraFields = importList.ExtraFields
+	state.TestOnApply = importList.TestOnApply
+	state.SecretsRevision = importList.SecretsRevision
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -346,6 +462,9 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state ImportList
 
+	state.ExtraFields = importList.ExtraFields
+	state.TestOnApply = importList.TestOnApply
+	state.SecretsRevision = importList.SecretsRevision
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -363,6 +482,10 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
@@ -375,6 +498,9 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state ImportList
 
+	state.ExtraFields = importList.ExtraFields
+	state.TestOnApply = importList.TestOnApply
+	state.SecretsRevision = importList.SecretsRevision
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -401,7 +527,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *ImportListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListResourceName, findImportListIDs(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+importListResourceName+": "+req.ID)
 }
 
@@ -425,6 +551,7 @@ func (i *ImportList) write(ctx context.Context, importList *sonarr.ImportListRes
 	i.ProfileIDs = types.SetValueMust(types.Int64Type, nil)
 	i.TagIDs = types.SetValueMust(types.Int64Type, nil)
 	helpers.WriteFields(ctx, i, importList.GetFields(), importListFields)
+	i.ExtraFields = helpers.WriteExtraFields(i.ExtraFields, importList.GetFields(), importListFields)
 }
 
 func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.ImportListResource {
@@ -440,7 +567,19 @@ func (i *ImportList) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.
 	list.SetImplementation(i.Implementation.ValueString())
 	list.SetName(i.Name.ValueString())
 	diags.Append(i.Tags.ElementsAs(ctx, &list.Tags, true)...)
-	list.SetFields(helpers.ReadFields(ctx, i, importListFields))
+	list.SetFields(helpers.ReadExtraFields(i.ExtraFields, helpers.ReadFields(ctx, i, importListFields)))
 
 	return list
 }
+
+// findImportListIDs returns a function listing the IDs of the import lists with the given name.
+// Unless empty, implementation must match too, as for adoption.
+func findImportListIDs(auth context.Context, client *sonarr.APIClient, implementation string) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.ImportListResource, error) {
+		response, _, err := client.ImportListAPI.ListImportList(auth).Execute()
+
+		return response, err
+	}, (*sonarr.ImportListResource).GetName, (*sonarr.ImportListResource).GetId, func(item *sonarr.ImportListResource) bool {
+		return implementation == "" || checkAdoptable(item.GetName(), implementation, item.GetImplementation(), "", "") == nil
+	})
+}
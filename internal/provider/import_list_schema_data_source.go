@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const importListSchemaDataSourceName = "import_list_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ImportListSchemaDataSource{}
+
+func NewImportListSchemaDataSource() datasource.DataSource {
+	return &ImportListSchemaDataSource{}
+}
+
+// ImportListSchemaDataSource defines the import list schema implementation.
+type ImportListSchemaDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *ImportListSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + importListSchemaDataSourceName
+}
+
+func (d *ImportListSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = implementationSchemaDataSourceSchema("Import Lists", "Import List", "import_list")
+}
+
+func (d *ImportListSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ImportListSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ImplementationSchemas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get import list schema current value
+	response, _, err := d.client.ImportListAPI.ListImportListSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+importListSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	implementations := make([]implementationSchemaResource, len(response))
+	for i := range response {
+		implementations[i] = &response[i]
+	}
+
+	data.write(ctx, implementations, &ImportList{}, importListFields, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImportListSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccImportListSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccImportListSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_import_list_schema.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_import_list_schema.test", "schemas.*", map[string]string{"implementation": "SonarrImport"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccImportListSchemaDataSourceConfig = `
+data "sonarr_import_list_schema" "test" {
+	implementation = "SonarrImport"
+}
+`
@@ -37,8 +37,9 @@ func NewImportListSimklUserResource() resource.Resource {
 
 // ImportListSimklUserResource defines the import list implementation.
 type ImportListSimklUserResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListSimklUser describes the import list data model.
@@ -55,8 +56,10 @@ type ImportListSimklUser struct {
 	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
 	ID                 types.Int64  `tfsdk:"id"`
 	ListType           types.Int64  `tfsdk:"list_type"`
+	SecretsRevision    types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListSimklUser) toImportList() *ImportList {
@@ -145,6 +148,8 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -161,13 +166,13 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
+				MarkdownDescription: "Access token. Renewed through the Sonarr OAuth proxy when expired, its rotation by Sonarr is not reported as drift.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
 			},
 			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
+				MarkdownDescription: "Refresh token, used to renew the access token.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
@@ -178,7 +183,7 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 			},
 			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -187,9 +192,10 @@ func (r *ImportListSimklUserResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *ImportListSimklUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -205,6 +211,11 @@ func (r *ImportListSimklUserResource) Create(ctx context.Context, req resource.C
 
 	// Create new ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListSimklUserResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
@@ -244,10 +255,11 @@ func (r *ImportListSimklUserResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListSimklUser
+	// Get plan and state values
+	var importList, state *ImportListSimklUser
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -255,6 +267,15 @@ func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.U
 
 	// Update ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListSimklUserResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
@@ -291,13 +312,15 @@ func (r *ImportListSimklUserResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListSimklUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListSimklUserResourceName, findImportListIDs(r.auth, r.client, importListSimklUserImplementation))
 	tflog.Trace(ctx, "imported "+importListSimklUserResourceName+": "+req.ID)
 }
 
 func (i *ImportListSimklUser) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
 	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
 	i.fromImportList(genericImportList)
 }
 
@@ -36,8 +36,9 @@ func NewImportListSonarrResource() resource.Resource {
 
 // ImportListSonarrResource defines the import list implementation.
 type ImportListSonarrResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListSonarr describes the import list data model.
@@ -54,8 +55,10 @@ type ImportListSonarr struct {
 	APIKey             types.String `tfsdk:"api_key"`
 	QualityProfileID   types.Int64  `tfsdk:"quality_profile_id"`
 	ID                 types.Int64  `tfsdk:"id"`
+	SecretsRevision    types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder       types.Bool   `tfsdk:"season_folder"`
+	TestOnApply        types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListSonarr) toImportList() *ImportList {
@@ -144,6 +147,8 @@ func (r *ImportListSonarrResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -160,6 +165,9 @@ func (r *ImportListSonarrResource) Schema(_ context.Context, _ resource.SchemaRe
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"language_profile_ids": schema.SetAttribute{
 				MarkdownDescription: "Language profile IDs.",
@@ -184,9 +192,10 @@ func (r *ImportListSonarrResource) Schema(_ context.Context, _ resource.SchemaRe
 }
 
 func (r *ImportListSonarrResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -203,6 +212,10 @@ func (r *ImportListSonarrResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListSonarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListSonarrResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSonarrResourceName, err))
@@ -253,6 +266,10 @@ func (r *ImportListSonarrResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListSonarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListSonarrResourceName, importList, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSonarrResourceName, err))
@@ -288,7 +305,7 @@ func (r *ImportListSonarrResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *ImportListSonarrResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListSonarrResourceName, findImportListIDs(r.auth, r.client, importListSonarrImplementation))
 	tflog.Trace(ctx, "imported "+importListSonarrResourceName+": "+req.ID)
 }
 
@@ -36,8 +36,9 @@ func NewImportListTraktListResource() resource.Resource {
 
 // ImportListTraktListResource defines the import list implementation.
 type ImportListTraktListResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListTraktList describes the import list data model.
@@ -57,8 +58,10 @@ type ImportListTraktList struct {
 	QualityProfileID          types.Int64  `tfsdk:"quality_profile_id"`
 	ID                        types.Int64  `tfsdk:"id"`
 	Limit                     types.Int64  `tfsdk:"limit"`
+	SecretsRevision           types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	TestOnApply               types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListTraktList) toImportList() *ImportList {
@@ -153,6 +156,8 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -167,13 +172,13 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 			},
 			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
+				MarkdownDescription: "Access token. Renewed through the Sonarr OAuth proxy when expired, its rotation by Sonarr is not reported as drift.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
 			},
 			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
+				MarkdownDescription: "Refresh token, used to renew the access token.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
@@ -188,7 +193,7 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 				Required:            true,
 			},
 			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -206,9 +211,10 @@ func (r *ImportListTraktListResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *ImportListTraktListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -224,6 +230,11 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 
 	// Create new ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
@@ -263,10 +274,11 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListTraktList
+	// Get plan and state values
+	var importList, state *ImportListTraktList
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -274,6 +286,15 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 
 	// Update ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktListResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
@@ -310,13 +331,15 @@ func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListTraktListResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListTraktListResourceName, findImportListIDs(r.auth, r.client, importListTraktListImplementation))
 	tflog.Trace(ctx, "imported "+importListTraktListResourceName+": "+req.ID)
 }
 
 func (i *ImportListTraktList) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
 	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
 	i.fromImportList(genericImportList)
 }
 
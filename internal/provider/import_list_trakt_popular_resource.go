@@ -37,8 +37,9 @@ func NewImportListTraktPopularResource() resource.Resource {
 
 // ImportListTraktPopularResource defines the import list implementation.
 type ImportListTraktPopularResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListTraktPopular describes the import list data model.
@@ -60,8 +61,10 @@ type ImportListTraktPopular struct {
 	ID                        types.Int64  `tfsdk:"id"`
 	Limit                     types.Int64  `tfsdk:"limit"`
 	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
+	SecretsRevision           types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	TestOnApply               types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListTraktPopular) toImportList() *ImportList {
@@ -160,6 +163,8 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -181,13 +186,13 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 				},
 			},
 			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
+				MarkdownDescription: "Access token. Renewed through the Sonarr OAuth proxy when expired, its rotation by Sonarr is not reported as drift.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
 			},
 			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
+				MarkdownDescription: "Refresh token, used to renew the access token.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
@@ -203,7 +208,7 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 				Computed:            true,
 			},
 			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -227,9 +232,10 @@ func (r *ImportListTraktPopularResource) Schema(_ context.Context, _ resource.Sc
 }
 
 func (r *ImportListTraktPopularResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -245,6 +251,11 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 
 	// Create new ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktPopularResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
@@ -284,10 +295,11 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 }
 
 func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListTraktPopular
+	// Get plan and state values
+	var importList, state *ImportListTraktPopular
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -295,6 +307,15 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktPopularResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
@@ -331,13 +352,15 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 }
 
 func (r *ImportListTraktPopularResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListTraktPopularResourceName, findImportListIDs(r.auth, r.client, importListTraktPopularImplementation))
 	tflog.Trace(ctx, "imported "+importListTraktPopularResourceName+": "+req.ID)
 }
 
 func (i *ImportListTraktPopular) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
 	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
 	i.fromImportList(genericImportList)
 }
 
@@ -37,8 +37,9 @@ func NewImportListTraktUserResource() resource.Resource {
 
 // ImportListTraktUserResource defines the import list implementation.
 type ImportListTraktUserResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // ImportListTraktUser describes the import list data model.
@@ -58,8 +59,10 @@ type ImportListTraktUser struct {
 	ID                        types.Int64  `tfsdk:"id"`
 	Limit                     types.Int64  `tfsdk:"limit"`
 	TraktListType             types.Int64  `tfsdk:"trakt_list_type"`
+	SecretsRevision           types.Int64  `tfsdk:"secrets_revision"`
 	EnableAutomaticAdd        types.Bool   `tfsdk:"enable_automatic_add"`
 	SeasonFolder              types.Bool   `tfsdk:"season_folder"`
+	TestOnApply               types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i ImportListTraktUser) toImportList() *ImportList {
@@ -154,6 +157,8 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Import List ID.",
 				Computed:            true,
@@ -175,13 +180,13 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 				},
 			},
 			"access_token": schema.StringAttribute{
-				MarkdownDescription: "Access token.",
+				MarkdownDescription: "Access token. Renewed through the Sonarr OAuth proxy when expired, its rotation by Sonarr is not reported as drift.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
 			},
 			"refresh_token": schema.StringAttribute{
-				MarkdownDescription: "Refresh token.",
+				MarkdownDescription: "Refresh token, used to renew the access token.",
 				Optional:            true,
 				Computed:            true,
 				Sensitive:           true,
@@ -192,7 +197,7 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 			},
 			"expires": schema.StringAttribute{
-				MarkdownDescription: "Expires.",
+				MarkdownDescription: "Access token expiration, in RFC3339 format.",
 				Optional:            true,
 				Computed:            true,
 			},
@@ -210,9 +215,10 @@ func (r *ImportListTraktUserResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *ImportListTraktUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -228,6 +234,11 @@ func (r *ImportListTraktUserResource) Create(ctx context.Context, req resource.C
 
 	// Create new ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), nil, &resp.Diagnostics)
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktUserResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
 	if err != nil {
@@ -267,10 +278,11 @@ func (r *ImportListTraktUserResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// Get plan values
-	var importList *ImportListTraktUser
+	// Get plan and state values
+	var importList, state *ImportListTraktUser
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &importList)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -278,6 +290,15 @@ func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.U
 
 	// Update ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
+	prepareImportListTokens(ctx, r.client, r.auth, request, importList.toImportList(), state.toImportList(), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testOnApply(importList.TestOnApply, r.testOnApply) && !testImportList(r.auth, r.client, request, importListTraktUserResourceName, importList, &resp.Diagnostics) {
+		return
+	}
 
 	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, request.GetId()).ImportListResource(*request).Execute()
 	if err != nil {
@@ -314,13 +335,15 @@ func (r *ImportListTraktUserResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *ImportListTraktUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, importListTraktUserResourceName, findImportListIDs(r.auth, r.client, importListTraktUserImplementation))
 	tflog.Trace(ctx, "imported "+importListTraktUserResourceName+": "+req.ID)
 }
 
 func (i *ImportListTraktUser) write(ctx context.Context, importList *sonarr.ImportListResource, diags *diag.Diagnostics) {
 	genericImportList := i.toImportList()
+	tokens := genericImportList.tokens()
 	genericImportList.write(ctx, importList, diags)
+	tokens.keep(genericImportList)
 	i.fromImportList(genericImportList)
 }
 
@@ -6,12 +6,15 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +38,10 @@ func NewIndexerBroadcastheNetResource() resource.Resource {
 
 // IndexerBroadcastheNetResource defines the BroadcastheNet indexer implementation.
 type IndexerBroadcastheNetResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // IndexerBroadcastheNet describes the BroadcastheNet indexer data model.
@@ -52,9 +57,11 @@ type IndexerBroadcastheNet struct {
 	MinimumSeeders          types.Int64   `tfsdk:"minimum_seeders"`
 	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
 	SeedTime                types.Int64   `tfsdk:"seed_time"`
+	SecretsRevision         types.Int64   `tfsdk:"secrets_revision"`
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerBroadcastheNet) toIndexer() *Indexer {
@@ -123,6 +130,9 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"download_client_id": schema.Int64Attribute{
 				MarkdownDescription: "Download client ID.",
@@ -139,6 +149,8 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerBroadcastheNet ID.",
 				Computed:            true,
@@ -166,10 +178,16 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key.",
@@ -181,9 +199,11 @@ func (r *IndexerBroadcastheNetResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *IndexerBroadcastheNetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -200,7 +220,11 @@ func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource
 	// Create new IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerBroadcastheNetResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerBroadcastheNetResourceName, err))
 
@@ -250,6 +274,10 @@ func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource
 	// Update IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerBroadcastheNetResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerBroadcastheNetResourceName, err))
@@ -285,7 +313,7 @@ func (r *IndexerBroadcastheNetResource) Delete(ctx context.Context, req resource
 }
 
 func (r *IndexerBroadcastheNetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, indexerBroadcastheNetResourceName, findIndexerIDs(r.auth, r.client, indexerBroadcastheNetImplementation, indexerBroadcastheNetProtocol))
 	tflog.Trace(ctx, "imported "+indexerBroadcastheNetResourceName+": "+req.ID)
 }
 
@@ -36,6 +36,18 @@ func (d *IndexerDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nSingle [Indexer](../resources/indexer).",
 		Attributes: map[string]schema.Attribute{
+			"secrets_revision": schema.Int64Attribute{
+				MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+				Computed:            true,
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Computed:            true,
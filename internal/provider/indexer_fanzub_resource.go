@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +37,10 @@ func NewIndexerFanzubResource() resource.Resource {
 
 // IndexerFanzubResource defines the Fanzub indexer implementation.
 type IndexerFanzubResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // IndexerFanzub describes the Fanzub indexer data model.
@@ -51,6 +55,7 @@ type IndexerFanzub struct {
 	EnableRss                 types.Bool   `tfsdk:"enable_rss"`
 	EnableInteractiveSearch   types.Bool   `tfsdk:"enable_interactive_search"`
 	EnableAutomaticSearch     types.Bool   `tfsdk:"enable_automatic_search"`
+	TestOnApply               types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerFanzub) toIndexer() *Indexer {
@@ -111,6 +116,9 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"download_client_id": schema.Int64Attribute{
 				MarkdownDescription: "Download client ID.",
@@ -127,6 +135,7 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerFanzub ID.",
 				Computed:            true,
@@ -144,15 +153,20 @@ func (r *IndexerFanzubResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 		},
 	}
 }
 
 func (r *IndexerFanzubResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -169,7 +183,11 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerFanzubResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFanzubResourceName, err))
 
@@ -219,6 +237,10 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerFanzubResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFanzubResourceName, err))
@@ -254,7 +276,7 @@ func (r *IndexerFanzubResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerFanzubResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, indexerFanzubResourceName, findIndexerIDs(r.auth, r.client, indexerFanzubImplementation, indexerFanzubProtocol))
 	tflog.Trace(ctx, "imported "+indexerFanzubResourceName+": "+req.ID)
 }
 
@@ -6,12 +6,15 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +38,10 @@ func NewIndexerFilelistResource() resource.Resource {
 
 // IndexerFilelistResource defines the Filelist indexer implementation.
 type IndexerFilelistResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // IndexerFilelist describes the Filelist indexer data model.
@@ -55,9 +60,11 @@ type IndexerFilelist struct {
 	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
 	SeedTime                types.Int64   `tfsdk:"seed_time"`
 	Priority                types.Int64   `tfsdk:"priority"`
+	SecretsRevision         types.Int64   `tfsdk:"secrets_revision"`
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerFilelist) toIndexer() *Indexer {
@@ -132,6 +139,9 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"download_client_id": schema.Int64Attribute{
 				MarkdownDescription: "Download client ID.",
@@ -148,6 +158,8 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerFilelist ID.",
 				Computed:            true,
@@ -175,11 +187,17 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"passkey": schema.StringAttribute{
 				MarkdownDescription: "Passkey.",
@@ -207,9 +225,11 @@ func (r *IndexerFilelistResource) Schema(_ context.Context, _ resource.SchemaReq
 }
 
 func (r *IndexerFilelistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -226,7 +246,11 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerFilelistResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
 
@@ -276,6 +300,10 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerFilelistResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
@@ -311,7 +339,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *IndexerFilelistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, indexerFilelistResourceName, findIndexerIDs(r.auth, r.client, indexerFilelistImplementation, indexerFilelistProtocol))
 	tflog.Trace(ctx, "imported "+indexerFilelistResourceName+": "+req.ID)
 }
 
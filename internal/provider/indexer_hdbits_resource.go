@@ -6,12 +6,15 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -35,8 +38,10 @@ func NewIndexerHdbitsResource() resource.Resource {
 
 // IndexerHdbitsResource defines the Hdbits indexer implementation.
 type IndexerHdbitsResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // IndexerHdbits describes the Hdbits indexer data model.
@@ -53,9 +58,11 @@ type IndexerHdbits struct {
 	SeasonPackSeedTime      types.Int64   `tfsdk:"season_pack_seed_time"`
 	SeedTime                types.Int64   `tfsdk:"seed_time"`
 	Priority                types.Int64   `tfsdk:"priority"`
+	SecretsRevision         types.Int64   `tfsdk:"secrets_revision"`
 	EnableAutomaticSearch   types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss               types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply             types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i IndexerHdbits) toIndexer() *Indexer {
@@ -126,6 +133,9 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"download_client_id": schema.Int64Attribute{
 				MarkdownDescription: "Download client ID.",
@@ -142,6 +152,8 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "IndexerHdbits ID.",
 				Computed:            true,
@@ -169,11 +181,17 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 			"base_url": schema.StringAttribute{
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key.",
@@ -189,9 +207,11 @@ func (r *IndexerHdbitsResource) Schema(_ context.Context, _ resource.SchemaReque
 }
 
 func (r *IndexerHdbitsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -208,7 +228,11 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerHdbitsResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
 
@@ -258,6 +282,10 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerHdbitsResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHdbitsResourceName, err))
@@ -293,7 +321,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 func (r *IndexerHdbitsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, indexerHdbitsResourceName, findIndexerIDs(r.auth, r.client, indexerHdbitsImplementation, indexerHdbitsProtocol))
 	tflog.Trace(ctx, "imported "+indexerHdbitsResourceName+": "+req.ID)
 }
 
@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -43,8 +45,10 @@ func NewIndexerResource() resource.Resource {
 
 // IndexerResource defines the indexer implementation.
 type IndexerResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // Indexer describes the indexer data model.
@@ -53,6 +57,7 @@ type Indexer struct {
 	Tags                      types.Set     `tfsdk:"tags"`
 	Categories                types.Set     `tfsdk:"categories"`
 	AnimeCategories           types.Set     `tfsdk:"anime_categories"`
+	ExtraFields               types.String  `tfsdk:"extra_fields"`
 	APIKey                    types.String  `tfsdk:"api_key"`
 	Username                  types.String  `tfsdk:"username"`
 	ConfigContract            types.String  `tfsdk:"config_contract"`
@@ -72,17 +77,21 @@ type Indexer struct {
 	Delay                     types.Int64   `tfsdk:"delay"`
 	ID                        types.Int64   `tfsdk:"id"`
 	SeasonPackSeedTime        types.Int64   `tfsdk:"season_pack_seed_time"`
+	SecretsRevision           types.Int64   `tfsdk:"secrets_revision"`
 	AnimeStandardFormatSearch types.Bool    `tfsdk:"anime_standard_format_search"`
 	AllowZeroSize             types.Bool    `tfsdk:"allow_zero_size"`
 	RankedOnly                types.Bool    `tfsdk:"ranked_only"`
 	EnableAutomaticSearch     types.Bool    `tfsdk:"enable_automatic_search"`
 	EnableRss                 types.Bool    `tfsdk:"enable_rss"`
 	EnableInteractiveSearch   types.Bool    `tfsdk:"enable_interactive_search"`
+	TestOnApply               types.Bool    `tfsdk:"test_on_apply"`
 }
 
 func (i Indexer) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
+			"test_on_apply":                types.BoolType,
+			"extra_fields":                 types.StringType,
 			"tags":                         types.SetType{}.WithElementType(types.Int64Type),
 			"categories":                   types.SetType{}.WithElementType(types.Int64Type),
 			"anime_categories":             types.SetType{}.WithElementType(types.Int64Type),
@@ -123,6 +132,14 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Indexers -->\nGeneric Indexer resource. When possible use a specific resource instead.\nFor more information refer to [Indexer](https://wiki.servarr.com/sonarr/settings#indexers) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields as JSON object (e.g. `jsonencode({ newField = true })`), to manage fields not supported by any other attribute. They are merged with the other fields, overriding them. When unset, it shows all the fields returned by Sonarr not supported by any other attribute.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
 			"enable_automatic_search": schema.BoolAttribute{
 				MarkdownDescription: "Enable automatic search flag.",
 				Optional:            true,
@@ -142,6 +159,9 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Priority.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 50),
+				},
 			},
 			"download_client_id": schema.Int64Attribute{
 				MarkdownDescription: "Download client ID.",
@@ -173,6 +193,8 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Indexer ID.",
 				Computed:            true,
@@ -220,6 +242,9 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Seed ratio.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
 			},
 			"additional_parameters": schema.StringAttribute{
 				MarkdownDescription: "Additional parameters.",
@@ -241,6 +266,9 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "Base URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"captcha_token": schema.StringAttribute{
 				MarkdownDescription: "Captcha token.",
@@ -280,9 +308,11 @@ func (r *IndexerResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 }
 
 func (r *IndexerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -299,7 +329,11 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createIndexer(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerResourceName, err))
 
@@ -311,6 +345,9 @@ func (r *IndexerResource) Create(ctx context.Context, req resource.CreateRequest
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Indexer
 
+	state.ExtraFields = indexer.ExtraFields
+	state.TestOnApply = indexer.TestOnApply
+	state.SecretsRevision = indexer.SecretsRevision
 	state.writeSensitive(indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -339,6 +376,9 @@ func (r *IndexerResource) Read(ctx context.Context, req resource.ReadRequest, re
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Indexer
 
+	state.ExtraFields = indexer.ExtraFields
+	state.TestOnApply = indexer.TestOnApply
+	state.SecretsRevision = indexer.SecretsRevision
 	state.writeSensitive(indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -357,6 +397,10 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update Indexer
 	request := indexer.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(indexer.TestOnApply, r.testOnApply) && !testIndexer(r.auth, r.client, request, indexerResourceName, indexer, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, request.GetId()).IndexerResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerResourceName, err))
@@ -369,6 +413,9 @@ func (r *IndexerResource) Update(ctx context.Context, req resource.UpdateRequest
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Indexer
 
+	state.ExtraFields = indexer.ExtraFields
+	state.TestOnApply = indexer.TestOnApply
+	state.SecretsRevision = indexer.SecretsRevision
 	state.writeSensitive(indexer)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -396,7 +443,7 @@ func (r *IndexerResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *IndexerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, indexerResourceName, findIndexerIDs(r.auth, r.client, "", ""))
 	tflog.Trace(ctx, "imported "+indexerResourceName+": "+req.ID)
 }
 
@@ -419,6 +466,7 @@ func (i *Indexer) write(ctx context.Context, indexer *sonarr.IndexerResource, di
 	i.AnimeCategories = types.SetValueMust(types.Int64Type, nil)
 	i.Categories = types.SetValueMust(types.Int64Type, nil)
 	helpers.WriteFields(ctx, i, indexer.GetFields(), indexerFields)
+	i.ExtraFields = helpers.WriteExtraFields(i.ExtraFields, indexer.GetFields(), indexerFields)
 }
 
 func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.IndexerResource {
@@ -434,7 +482,7 @@ func (i *Indexer) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Ind
 	indexer.SetName(i.Name.ValueString())
 	indexer.SetProtocol(sonarr.DownloadProtocol(i.Protocol.ValueString()))
 	diags.Append(i.Tags.ElementsAs(ctx, &indexer.Tags, true)...)
-	indexer.SetFields(helpers.ReadFields(ctx, i, indexerFields))
+	indexer.SetFields(helpers.ReadExtraFields(i.ExtraFields, helpers.ReadFields(ctx, i, indexerFields)))
 
 	return indexer
 }
@@ -449,3 +497,15 @@ func (i *Indexer) writeSensitive(indexer *Indexer) {
 		i.APIKey = indexer.APIKey
 	}
 }
+
+// findIndexerIDs returns a function listing the IDs of the indexers with the given name.
+// Unless empty, implementation and protocol must match too, as for adoption.
+func findIndexerIDs(auth context.Context, client *sonarr.APIClient, implementation, protocol string) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.IndexerResource, error) {
+		response, _, err := client.IndexerAPI.ListIndexer(auth).Execute()
+
+		return response, err
+	}, (*sonarr.IndexerResource).GetName, (*sonarr.IndexerResource).GetId, func(item *sonarr.IndexerResource) bool {
+		return implementation == "" || checkAdoptable(item.GetName(), implementation, item.GetImplementation(), protocol, string(item.GetProtocol())) == nil
+	})
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const indexerSchemaDataSourceName = "indexer_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &IndexerSchemaDataSource{}
+
+func NewIndexerSchemaDataSource() datasource.DataSource {
+	return &IndexerSchemaDataSource{}
+}
+
+// IndexerSchemaDataSource defines the indexer schema implementation.
+type IndexerSchemaDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *IndexerSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + indexerSchemaDataSourceName
+}
+
+func (d *IndexerSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = implementationSchemaDataSourceSchema("Indexers", "Indexer", "indexer")
+}
+
+func (d *IndexerSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *IndexerSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ImplementationSchemas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get indexer schema current value
+	response, _, err := d.client.IndexerAPI.ListIndexerSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+indexerSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	implementations := make([]implementationSchemaResource, len(response))
+	for i := range response {
+		implementations[i] = &response[i]
+	}
+
+	data.write(ctx, implementations, &Indexer{}, indexerFields, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIndexerSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccIndexerSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccIndexerSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_indexer_schema.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_indexer_schema.test", "schemas.*", map[string]string{"implementation": "Newznab"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccIndexerSchemaDataSourceConfig = `
+data "sonarr_indexer_schema" "test" {
+	implementation = "Newznab"
+}
+`
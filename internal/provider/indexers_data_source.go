@@ -51,6 +51,18 @@ func (d *IndexersDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"secrets_revision": schema.Int64Attribute{
+							MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+							Computed:            true,
+						},
+						"test_on_apply": schema.BoolAttribute{
+							MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+							Computed:            true,
+						},
+						"extra_fields": schema.StringAttribute{
+							MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+							Computed:            true,
+						},
 						"enable_automatic_search": schema.BoolAttribute{
 							MarkdownDescription: "Enable automatic search flag.",
 							Computed:            true,
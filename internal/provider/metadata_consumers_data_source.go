@@ -51,6 +51,14 @@ func (d *MetadataConsumersDataSource) Schema(_ context.Context, _ datasource.Sch
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"test_on_apply": schema.BoolAttribute{
+							MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+							Computed:            true,
+						},
+						"extra_fields": schema.StringAttribute{
+							MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+							Computed:            true,
+						},
 						"enable": schema.BoolAttribute{
 							MarkdownDescription: "Enable flag.",
 							Computed:            true,
@@ -101,6 +109,10 @@ func (d *MetadataConsumersDataSource) Schema(_ context.Context, _ datasource.Sch
 							MarkdownDescription: "Series metadata URL flag.",
 							Computed:            true,
 						},
+						"series_plex_match_file": schema.BoolAttribute{
+							MarkdownDescription: "Series Plex match file flag.",
+							Computed:            true,
+						},
 					},
 				},
 			},
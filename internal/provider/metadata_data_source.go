@@ -36,6 +36,14 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nSingle [Metadata](../resources/metadata).",
 		Attributes: map[string]schema.Attribute{
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+				Computed:            true,
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Computed:            true,
@@ -86,6 +94,10 @@ func (d *MetadataDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 				MarkdownDescription: "Series metadata URL flag.",
 				Computed:            true,
 			},
+			"series_plex_match_file": schema.BoolAttribute{
+				MarkdownDescription: "Series Plex match file flag.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -34,8 +34,9 @@ func NewMetadataKodiResource() resource.Resource {
 
 // MetadataKodiResource defines the Kodi metadata implementation.
 type MetadataKodiResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // MetadataKodi describes the Kodi metadata data model.
@@ -50,6 +51,7 @@ type MetadataKodi struct {
 	SeasonImages      types.Bool   `tfsdk:"season_images"`
 	EpisodeMetadata   types.Bool   `tfsdk:"episode_metadata"`
 	EpisodeImages     types.Bool   `tfsdk:"episode_images"`
+	TestOnApply       types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (m MetadataKodi) toMetadata() *Metadata {
@@ -105,6 +107,7 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -142,9 +145,10 @@ func (r *MetadataKodiResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *MetadataKodiResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -161,6 +165,10 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataKodiResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
@@ -211,6 +219,10 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataKodiResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
@@ -246,7 +258,7 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataKodiResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, metadataKodiResourceName, findMetadataIDs(r.auth, r.client, metadataKodiImplementation))
 	tflog.Trace(ctx, "imported "+metadataKodiResourceName+": "+req.ID)
 }
 
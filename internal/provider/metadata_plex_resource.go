@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	metadataPlexResourceName   = "metadata_plex"
+	metadataPlexImplementation = "PlexMetadata"
+	metadataPlexConfigContract = "PlexMetadataSettings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &MetadataPlexResource{}
+	_ resource.ResourceWithImportState = &MetadataPlexResource{}
+)
+
+func NewMetadataPlexResource() resource.Resource {
+	return &MetadataPlexResource{}
+}
+
+// MetadataPlexResource defines the Plex metadata implementation.
+type MetadataPlexResource struct {
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
+}
+
+// MetadataPlex describes the Plex metadata data model.
+type MetadataPlex struct {
+	Tags                types.Set    `tfsdk:"tags"`
+	Name                types.String `tfsdk:"name"`
+	ID                  types.Int64  `tfsdk:"id"`
+	Enable              types.Bool   `tfsdk:"enable"`
+	SeriesPlexMatchFile types.Bool   `tfsdk:"series_plex_match_file"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (m MetadataPlex) toMetadata() *Metadata {
+	return &Metadata{
+		Tags:                m.Tags,
+		Name:                m.Name,
+		ID:                  m.ID,
+		Enable:              m.Enable,
+		SeriesPlexMatchFile: m.SeriesPlexMatchFile,
+		ConfigContract:      types.StringValue(metadataPlexConfigContract),
+		Implementation:      types.StringValue(metadataPlexImplementation),
+	}
+}
+
+func (m *MetadataPlex) fromMetadata(metadata *Metadata) {
+	m.ID = metadata.ID
+	m.Name = metadata.Name
+	m.Tags = metadata.Tags
+	m.Enable = metadata.Enable
+	m.SeriesPlexMatchFile = metadata.SeriesPlexMatchFile
+}
+
+func (r *MetadataPlexResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + metadataPlexResourceName
+}
+
+func (r *MetadataPlexResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Metadata -->\nMetadata Plex resource.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) and [Plex](https://wiki.servarr.com/sonarr/supported#plexmetadata).",
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Enable flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Metadata name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"test_on_apply": testOnApplyAttribute,
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Metadata ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"series_plex_match_file": schema.BoolAttribute{
+				MarkdownDescription: "Series Plex match file flag.",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *MetadataPlexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
+	}
+}
+
+func (r *MetadataPlexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var metadata *MetadataPlex
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new MetadataPlex
+	request := metadata.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataPlexResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataPlexResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+metadataPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	metadata.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+}
+
+func (r *MetadataPlexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var metadata *MetadataPlex
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &metadata)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get MetadataPlex current value
+	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataPlexResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+metadataPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	metadata.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+}
+
+func (r *MetadataPlexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var metadata *MetadataPlex
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &metadata)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update MetadataPlex
+	request := metadata.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataPlexResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataPlexResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+metadataPlexResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	metadata.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &metadata)...)
+}
+
+func (r *MetadataPlexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete MetadataPlex current value
+	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataPlexResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+metadataPlexResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *MetadataPlexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, metadataPlexResourceName, findMetadataIDs(r.auth, r.client, metadataPlexImplementation))
+	tflog.Trace(ctx, "imported "+metadataPlexResourceName+": "+req.ID)
+}
+
+func (m *MetadataPlex) write(ctx context.Context, metadata *sonarr.MetadataResource, diags *diag.Diagnostics) {
+	genericMetadata := m.toMetadata()
+	genericMetadata.write(ctx, metadata, diags)
+	m.fromMetadata(genericMetadata)
+}
+
+func (m *MetadataPlex) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.MetadataResource {
+	return m.toMetadata().read(ctx, diags)
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetadataPlexResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccMetadataPlexResourceConfig("plexResourceTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccMetadataPlexResourceConfig("plexResourceTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_metadata_plex.test", "series_plex_match_file", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_metadata_plex.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccMetadataPlexResourceConfig("plexResourceTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccMetadataPlexResourceConfig("plexResourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_metadata_plex.test", "series_plex_match_file", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_metadata_plex.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccMetadataPlexResourceConfig(name, metadata string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_metadata_plex" "test" {
+		enable = false
+		name = "%s"
+		series_plex_match_file = %s
+	}`, name, metadata)
+}
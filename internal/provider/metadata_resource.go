@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,7 +27,7 @@ var (
 )
 
 var metadataFields = helpers.Fields{
-	Bools: []string{"seriesMetadata", "seriesMetadataUrl", "seriesImages", "seasonImages", "episodeImages", "episodeMetadata"},
+	Bools: []string{"seriesMetadata", "seriesMetadataUrl", "seriesPlexMatchFile", "seriesImages", "seasonImages", "episodeImages", "episodeMetadata"},
 }
 
 func NewMetadataResource() resource.Resource {
@@ -35,41 +36,48 @@ func NewMetadataResource() resource.Resource {
 
 // MetadataResource defines the metadata implementation.
 type MetadataResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // Metadata describes the metadata data model.
 type Metadata struct {
-	Tags              types.Set    `tfsdk:"tags"`
-	Name              types.String `tfsdk:"name"`
-	ConfigContract    types.String `tfsdk:"config_contract"`
-	Implementation    types.String `tfsdk:"implementation"`
-	ID                types.Int64  `tfsdk:"id"`
-	Enable            types.Bool   `tfsdk:"enable"`
-	SeriesMetadata    types.Bool   `tfsdk:"series_metadata"`
-	SeriesMetadataURL types.Bool   `tfsdk:"series_metadata_url"`
-	SeriesImages      types.Bool   `tfsdk:"series_images"`
-	SeasonImages      types.Bool   `tfsdk:"season_images"`
-	EpisodeMetadata   types.Bool   `tfsdk:"episode_metadata"`
-	EpisodeImages     types.Bool   `tfsdk:"episode_images"`
+	Tags                types.Set    `tfsdk:"tags"`
+	ExtraFields         types.String `tfsdk:"extra_fields"`
+	Name                types.String `tfsdk:"name"`
+	ConfigContract      types.String `tfsdk:"config_contract"`
+	Implementation      types.String `tfsdk:"implementation"`
+	ID                  types.Int64  `tfsdk:"id"`
+	Enable              types.Bool   `tfsdk:"enable"`
+	SeriesMetadata      types.Bool   `tfsdk:"series_metadata"`
+	SeriesMetadataURL   types.Bool   `tfsdk:"series_metadata_url"`
+	SeriesPlexMatchFile types.Bool   `tfsdk:"series_plex_match_file"`
+	SeriesImages        types.Bool   `tfsdk:"series_images"`
+	SeasonImages        types.Bool   `tfsdk:"season_images"`
+	EpisodeMetadata     types.Bool   `tfsdk:"episode_metadata"`
+	EpisodeImages       types.Bool   `tfsdk:"episode_images"`
+	TestOnApply         types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (m Metadata) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
-			"tags":                types.SetType{}.WithElementType(types.Int64Type),
-			"name":                types.StringType,
-			"config_contract":     types.StringType,
-			"implementation":      types.StringType,
-			"id":                  types.Int64Type,
-			"enable":              types.BoolType,
-			"series_metadata":     types.BoolType,
-			"series_metadata_url": types.BoolType,
-			"series_images":       types.BoolType,
-			"season_images":       types.BoolType,
-			"episode_metadata":    types.BoolType,
-			"episode_images":      types.BoolType,
+			"test_on_apply":          types.BoolType,
+			"extra_fields":           types.StringType,
+			"tags":                   types.SetType{}.WithElementType(types.Int64Type),
+			"name":                   types.StringType,
+			"config_contract":        types.StringType,
+			"implementation":         types.StringType,
+			"id":                     types.Int64Type,
+			"enable":                 types.BoolType,
+			"series_metadata":        types.BoolType,
+			"series_metadata_url":    types.BoolType,
+			"series_plex_match_file": types.BoolType,
+			"series_images":          types.BoolType,
+			"season_images":          types.BoolType,
+			"episode_metadata":       types.BoolType,
+			"episode_images":         types.BoolType,
 		})
 }
 
@@ -81,6 +89,14 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Metadata -->\nGeneric Metadata resource. When possible use a specific resource instead.\nFor more information refer to [Metadata](https://wiki.servarr.com/sonarr/settings#metadata) documentation.",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields as JSON object (e.g. `jsonencode({ newField = true })`), to manage fields not supported by any other attribute. They are merged with the other fields, overriding them. When unset, it shows all the fields returned by Sonarr not supported by any other attribute.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
 			"enable": schema.BoolAttribute{
 				MarkdownDescription: "Enable flag.",
 				Optional:            true,
@@ -104,6 +120,7 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -142,14 +159,20 @@ func (r *MetadataResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Optional:            true,
 				Computed:            true,
 			},
+			"series_plex_match_file": schema.BoolAttribute{
+				MarkdownDescription: "Series Plex match file flag.",
+				Optional:            true,
+				Computed:            true,
+			},
 		},
 	}
 }
 
 func (r *MetadataResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -166,6 +189,10 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
@@ -178,6 +205,8 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Metadata
 
+	state.ExtraFields = metadata.ExtraFields
+	state.TestOnApply = metadata.TestOnApply
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -205,6 +234,8 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Metadata
 
+	state.ExtraFields = metadata.ExtraFields
+	state.TestOnApply = metadata.TestOnApply
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -222,6 +253,10 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
@@ -234,6 +269,8 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Metadata
 
+	state.ExtraFields = metadata.ExtraFields
+	state.TestOnApply = metadata.TestOnApply
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
 }
@@ -260,7 +297,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 func (r *MetadataResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, metadataResourceName, findMetadataIDs(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+metadataResourceName+": "+req.ID)
 }
 
@@ -275,6 +312,7 @@ func (m *Metadata) write(ctx context.Context, metadata *sonarr.MetadataResource,
 	m.Tags, localDiag = types.SetValueFrom(ctx, types.Int64Type, metadata.Tags)
 	diags.Append(localDiag...)
 	helpers.WriteFields(ctx, m, metadata.GetFields(), metadataFields)
+	m.ExtraFields = helpers.WriteExtraFields(m.ExtraFields, metadata.GetFields(), metadataFields)
 }
 
 func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.MetadataResource {
@@ -285,7 +323,19 @@ func (m *Metadata) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Me
 	metadata.SetImplementation(m.Implementation.ValueString())
 	metadata.SetName(m.Name.ValueString())
 	diags.Append(m.Tags.ElementsAs(ctx, &metadata.Tags, true)...)
-	metadata.SetFields(helpers.ReadFields(ctx, m, metadataFields))
+	metadata.SetFields(helpers.ReadExtraFields(m.ExtraFields, helpers.ReadFields(ctx, m, metadataFields)))
 
 	return metadata
 }
+
+// findMetadataIDs returns a function listing the IDs of the metadata with the given name.
+// Unless empty, implementation must match too, as for adoption.
+func findMetadataIDs(auth context.Context, client *sonarr.APIClient, implementation string) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.MetadataResource, error) {
+		response, _, err := client.MetadataAPI.ListMetadata(auth).Execute()
+
+		return response, err
+	}, (*sonarr.MetadataResource).GetName, (*sonarr.MetadataResource).GetId, func(item *sonarr.MetadataResource) bool {
+		return implementation == "" || checkAdoptable(item.GetName(), implementation, item.GetImplementation(), "", "") == nil
+	})
+}
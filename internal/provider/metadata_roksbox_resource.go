@@ -34,8 +34,9 @@ func NewMetadataRoksboxResource() resource.Resource {
 
 // MetadataRoksboxResource defines the Roksbox metadata implementation.
 type MetadataRoksboxResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // MetadataRoksbox describes the Roksbox metadata data model.
@@ -48,6 +49,7 @@ type MetadataRoksbox struct {
 	SeasonImages    types.Bool   `tfsdk:"season_images"`
 	EpisodeMetadata types.Bool   `tfsdk:"episode_metadata"`
 	EpisodeImages   types.Bool   `tfsdk:"episode_images"`
+	TestOnApply     types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (m MetadataRoksbox) toMetadata() *Metadata {
@@ -99,6 +101,7 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -128,9 +131,10 @@ func (r *MetadataRoksboxResource) Schema(_ context.Context, _ resource.SchemaReq
 }
 
 func (r *MetadataRoksboxResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -147,6 +151,10 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataRoksboxResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
@@ -197,6 +205,10 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataRoksboxResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
@@ -232,7 +244,7 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 }
 
 func (r *MetadataRoksboxResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, metadataRoksboxResourceName, findMetadataIDs(r.auth, r.client, metadataRoksboxImplementation))
 	tflog.Trace(ctx, "imported "+metadataRoksboxResourceName+": "+req.ID)
 }
 
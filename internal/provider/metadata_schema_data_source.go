@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const metadataSchemaDataSourceName = "metadata_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MetadataSchemaDataSource{}
+
+func NewMetadataSchemaDataSource() datasource.DataSource {
+	return &MetadataSchemaDataSource{}
+}
+
+// MetadataSchemaDataSource defines the metadata schema implementation.
+type MetadataSchemaDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *MetadataSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + metadataSchemaDataSourceName
+}
+
+func (d *MetadataSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = implementationSchemaDataSourceSchema("Metadata", "Metadata", "metadata")
+}
+
+func (d *MetadataSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *MetadataSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ImplementationSchemas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get metadata schema current value
+	response, _, err := d.client.MetadataAPI.ListMetadataSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+metadataSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	implementations := make([]implementationSchemaResource, len(response))
+	for i := range response {
+		implementations[i] = &response[i]
+	}
+
+	data.write(ctx, implementations, &Metadata{}, metadataFields, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMetadataSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccMetadataSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccMetadataSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_metadata_schema.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_metadata_schema.test", "schemas.*", map[string]string{"implementation": "XbmcMetadata"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccMetadataSchemaDataSourceConfig = `
+data "sonarr_metadata_schema" "test" {
+	implementation = "XbmcMetadata"
+}
+`
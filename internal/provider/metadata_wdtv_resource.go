@@ -34,8 +34,9 @@ func NewMetadataWdtvResource() resource.Resource {
 
 // MetadataWdtvResource defines the Wdtv metadata implementation.
 type MetadataWdtvResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client      *sonarr.APIClient
+	auth        context.Context
+	testOnApply bool
 }
 
 // MetadataWdtv describes the Wdtv metadata data model.
@@ -48,6 +49,7 @@ type MetadataWdtv struct {
 	SeasonImages    types.Bool   `tfsdk:"season_images"`
 	EpisodeMetadata types.Bool   `tfsdk:"episode_metadata"`
 	EpisodeImages   types.Bool   `tfsdk:"episode_images"`
+	TestOnApply     types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (m MetadataWdtv) toMetadata() *Metadata {
@@ -99,6 +101,7 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Metadata ID.",
 				Computed:            true,
@@ -128,9 +131,10 @@ func (r *MetadataWdtvResource) Schema(_ context.Context, _ resource.SchemaReques
 }
 
 func (r *MetadataWdtvResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -147,6 +151,10 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataWdtvResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
@@ -197,6 +205,10 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(metadata.TestOnApply, r.testOnApply) && !testMetadata(r.auth, r.client, request, metadataWdtvResourceName, metadata, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, request.GetId()).MetadataResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
@@ -232,7 +244,7 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *MetadataWdtvResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, metadataWdtvResourceName, findMetadataIDs(r.auth, r.client, metadataWdtvImplementation))
 	tflog.Trace(ctx, "imported "+metadataWdtvResourceName+": "+req.ID)
 }
 
@@ -0,0 +1,636 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+// roundTripFunc maps the input state to the API struct and back to the output state.
+type roundTripFunc func(ctx context.Context, input tfsdk.State, output *tfsdk.State, diags *diag.Diagnostics)
+
+// resourceOnlyAttributes are not sent to Sonarr, so they are not expected to round trip.
+var resourceOnlyAttributes = []string{"test_on_apply", "secrets_revision"}
+
+func ctxRoundTrip[M, A any](read func(*M, context.Context, *diag.Diagnostics) *A, write func(*M, context.Context, *A, *diag.Diagnostics)) roundTripFunc {
+	return func(ctx context.Context, input tfsdk.State, output *tfsdk.State, diags *diag.Diagnostics) {
+		var model, result M
+
+		diags.Append(input.Get(ctx, &model)...)
+		write(&result, ctx, testJSONRoundTrip(read(&model, ctx, diags), diags), diags)
+		diags.Append(output.Set(ctx, &result)...)
+	}
+}
+
+func plainRoundTrip[M, A any](read func(*M) *A, write func(*M, *A)) roundTripFunc {
+	return func(ctx context.Context, input tfsdk.State, output *tfsdk.State, diags *diag.Diagnostics) {
+		var model, result M
+
+		diags.Append(input.Get(ctx, &model)...)
+		write(&result, testJSONRoundTrip(read(&model), diags))
+		diags.Append(output.Set(ctx, &result)...)
+	}
+}
+
+// hostRoundTrip keeps the authentication block, as the password is never returned by Sonarr.
+func hostRoundTrip(ctx context.Context, input tfsdk.State, output *tfsdk.State, diags *diag.Diagnostics) {
+	var model Host
+
+	diags.Append(input.Get(ctx, &model)...)
+	result := Host{AuthConfig: model.AuthConfig}
+	result.write(ctx, testJSONRoundTrip(model.read(ctx, diags), diags), diags)
+	diags.Append(output.Set(ctx, &result)...)
+}
+
+// testJSONRoundTrip sends the API struct through JSON, as it happens on the wire.
+func testJSONRoundTrip[A any](request *A, diags *diag.Diagnostics) *A {
+	var response A
+
+	data, err := json.Marshal(request)
+	if err == nil {
+		err = json.Unmarshal(data, &response)
+	}
+
+	if err != nil {
+		diags.AddError("JSON Error", err.Error())
+	}
+
+	return &response
+}
+
+// roundTripEnums are valid values for the string attributes mapped to API enums, by attribute name.
+var roundTripEnums = map[string]string{
+	"download_propers_repacks": "doNotUpgrade",
+	"episode_title_required":   "bulkSeasonReleases",
+	"file_date":                "localAirDate",
+	"older_priority":           "first",
+	"preferred_protocol":       "torrent",
+	"protocol":                 "torrent",
+	"recent_priority":          "last",
+	"rescan_after_refresh":     "afterManual",
+	"series_type":              "anime",
+	"should_monitor":           "existing",
+	"source":                   "web",
+}
+
+// roundTripAlternatives are left null and not compared, as they are derived from the attributes actually round tripping.
+var roundTripAlternatives = []string{
+	"allowed_qualities",
+	"colon_replacement_format_name",
+	"cutoff_quality",
+	"export_json",
+	"format_scores",
+	"initial_state_name",
+	"intial_state",
+	"multi_episode_style_name",
+	"notification_type_name",
+	"older_priority_name",
+	"older_tv_priority_name",
+	"recent_priority_name",
+	"recent_tv_priority_name",
+}
+
+// testValueGenerator fills every attribute with a distinct value, so that swapped mappings are detected.
+// String attributes with a fixed set of values get the given one.
+type testValueGenerator struct {
+	values  map[string]string
+	counter int64
+}
+
+func (g *testValueGenerator) attribute(name string, valueType tftypes.Type) tftypes.Value {
+	if slices.Contains(roundTripAlternatives, name) {
+		return tftypes.NewValue(valueType, nil)
+	}
+
+	if value, ok := g.values[name]; ok && valueType.Is(tftypes.String) {
+		return tftypes.NewValue(valueType, value)
+	}
+
+	return g.value(valueType)
+}
+
+func (g *testValueGenerator) value(valueType tftypes.Type) tftypes.Value {
+	g.counter++
+
+	switch {
+	case valueType.Is(tftypes.String):
+		return tftypes.NewValue(valueType, fmt.Sprintf("value%d", g.counter))
+	case valueType.Is(tftypes.Number):
+		return tftypes.NewValue(valueType, big.NewFloat(float64(g.counter)))
+	case valueType.Is(tftypes.Bool):
+		return tftypes.NewValue(valueType, g.counter%2 == 0)
+	case valueType.Is(tftypes.List{}):
+		elementType := valueType.(tftypes.List).ElementType
+
+		return tftypes.NewValue(valueType, []tftypes.Value{g.value(elementType), g.value(elementType)})
+	case valueType.Is(tftypes.Set{}):
+		elementType := valueType.(tftypes.Set).ElementType
+
+		return tftypes.NewValue(valueType, []tftypes.Value{g.value(elementType), g.value(elementType)})
+	case valueType.Is(tftypes.Map{}):
+		return tftypes.NewValue(valueType, map[string]tftypes.Value{"key": g.value(valueType.(tftypes.Map).ElementType)})
+	case valueType.Is(tftypes.Object{}):
+		attributes := make(map[string]tftypes.Value)
+		for name, attributeType := range valueType.(tftypes.Object).AttributeTypes {
+			attributes[name] = g.attribute(name, attributeType)
+		}
+
+		return tftypes.NewValue(valueType, attributes)
+	}
+
+	panic(fmt.Sprintf("unexpected type %s", valueType))
+}
+
+// testRoundTripState returns a state with every attribute filled.
+func testRoundTripState(ctx context.Context, r resource.Resource, values map[string]string) tfsdk.State {
+	resp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, resp)
+
+	generator := &testValueGenerator{values: make(map[string]string)}
+	for _, enums := range []map[string]string{roundTripEnums, values} {
+		for name, value := range enums {
+			generator.values[name] = value
+		}
+	}
+
+	// extra fields must be a JSON object
+	generator.values["extra_fields"] = "{}"
+
+	return tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    generator.value(resp.Schema.Type().TerraformType(ctx)),
+	}
+}
+
+func TestModelRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		values    map[string]string
+		resource  func() resource.Resource
+		roundTrip roundTripFunc
+	}{
+		"auto_tag": {
+			resource:  NewAutoTagResource,
+			roundTrip: ctxRoundTrip((*AutoTag).read, (*AutoTag).write),
+		},
+		"custom_format": {
+			resource:  NewCustomFormatResource,
+			roundTrip: ctxRoundTrip((*CustomFormat).read, (*CustomFormat).write),
+		},
+		"delay_profile": {
+			resource:  NewDelayProfileResource,
+			roundTrip: ctxRoundTrip((*DelayProfile).read, (*DelayProfile).write),
+		},
+		"download_client": {
+			resource:  NewDownloadClientResource,
+			roundTrip: ctxRoundTrip((*DownloadClient).read, (*DownloadClient).write),
+		},
+		"download_client_aria2": {
+			resource:  NewDownloadClientAria2Resource,
+			roundTrip: ctxRoundTrip((*DownloadClientAria2).read, (*DownloadClientAria2).write),
+		},
+		"download_client_config": {
+			resource:  NewDownloadClientConfigResource,
+			roundTrip: plainRoundTrip((*DownloadClientConfig).read, (*DownloadClientConfig).write),
+		},
+		"download_client_deluge": {
+			resource:  NewDownloadClientDelugeResource,
+			roundTrip: ctxRoundTrip((*DownloadClientDeluge).read, (*DownloadClientDeluge).write),
+		},
+		"download_client_flood": {
+			resource:  NewDownloadClientFloodResource,
+			roundTrip: ctxRoundTrip((*DownloadClientFlood).read, (*DownloadClientFlood).write),
+		},
+		"download_client_freebox": {
+			resource:  NewDownloadClientFreeboxResource,
+			roundTrip: ctxRoundTrip((*DownloadClientFreebox).read, (*DownloadClientFreebox).write),
+		},
+		"download_client_hadouken": {
+			resource:  NewDownloadClientHadoukenResource,
+			roundTrip: ctxRoundTrip((*DownloadClientHadouken).read, (*DownloadClientHadouken).write),
+		},
+		"download_client_nzbget": {
+			resource:  NewDownloadClientNzbgetResource,
+			roundTrip: ctxRoundTrip((*DownloadClientNzbget).read, (*DownloadClientNzbget).write),
+		},
+		"download_client_nzbvortex": {
+			resource:  NewDownloadClientNzbvortexResource,
+			roundTrip: ctxRoundTrip((*DownloadClientNzbvortex).read, (*DownloadClientNzbvortex).write),
+		},
+		"download_client_pneumatic": {
+			resource:  NewDownloadClientPneumaticResource,
+			roundTrip: ctxRoundTrip((*DownloadClientPneumatic).read, (*DownloadClientPneumatic).write),
+		},
+		"download_client_qbittorrent": {
+			resource:  NewDownloadClientQbittorrentResource,
+			roundTrip: ctxRoundTrip((*DownloadClientQbittorrent).read, (*DownloadClientQbittorrent).write),
+		},
+		"download_client_rtorrent": {
+			resource:  NewDownloadClientRtorrentResource,
+			roundTrip: ctxRoundTrip((*DownloadClientRtorrent).read, (*DownloadClientRtorrent).write),
+		},
+		"download_client_sabnzbd": {
+			resource:  NewDownloadClientSabnzbdResource,
+			roundTrip: ctxRoundTrip((*DownloadClientSabnzbd).read, (*DownloadClientSabnzbd).write),
+		},
+		"download_client_torrent_blackhole": {
+			resource:  NewDownloadClientTorrentBlackholeResource,
+			roundTrip: ctxRoundTrip((*DownloadClientTorrentBlackhole).read, (*DownloadClientTorrentBlackhole).write),
+		},
+		"download_client_torrent_download_station": {
+			resource:  NewDownloadClientTorrentDownloadStationResource,
+			roundTrip: ctxRoundTrip((*DownloadClientTorrentDownloadStation).read, (*DownloadClientTorrentDownloadStation).write),
+		},
+		"download_client_transmission": {
+			resource:  NewDownloadClientTransmissionResource,
+			roundTrip: ctxRoundTrip((*DownloadClientTransmission).read, (*DownloadClientTransmission).write),
+		},
+		"download_client_usenet_blackhole": {
+			resource:  NewDownloadClientUsenetBlackholeResource,
+			roundTrip: ctxRoundTrip((*DownloadClientUsenetBlackhole).read, (*DownloadClientUsenetBlackhole).write),
+		},
+		"download_client_usenet_download_station": {
+			resource:  NewDownloadClientUsenetDownloadStationResource,
+			roundTrip: ctxRoundTrip((*DownloadClientUsenetDownloadStation).read, (*DownloadClientUsenetDownloadStation).write),
+		},
+		"download_client_utorrent": {
+			resource:  NewDownloadClientUtorrentResource,
+			roundTrip: ctxRoundTrip((*DownloadClientUtorrent).read, (*DownloadClientUtorrent).write),
+		},
+		"download_client_vuze": {
+			resource:  NewDownloadClientVuzeResource,
+			roundTrip: ctxRoundTrip((*DownloadClientVuze).read, (*DownloadClientVuze).write),
+		},
+		"host": {
+			values: map[string]string{
+				"certificate_validation": "disabled",
+				"encrypted_password":     "password",
+				"mechanism":              "docker",
+				"method":                 "forms",
+				"password":               "password",
+				"required":               "disabledForLocalAddresses",
+				"type":                   "http",
+			},
+			resource:  NewHostResource,
+			roundTrip: hostRoundTrip,
+		},
+		"import_list": {
+			resource:  NewImportListResource,
+			roundTrip: ctxRoundTrip((*ImportList).read, (*ImportList).write),
+		},
+		"import_list_anilist": {
+			resource:  NewImportListAniListResource,
+			roundTrip: ctxRoundTrip((*ImportListAniList).read, (*ImportListAniList).write),
+		},
+		"import_list_custom": {
+			resource:  NewImportListCustomResource,
+			roundTrip: ctxRoundTrip((*ImportListCustom).read, (*ImportListCustom).write),
+		},
+		"import_list_exclusion": {
+			resource:  NewImportListExclusionResource,
+			roundTrip: plainRoundTrip((*ImportListExclusion).read, (*ImportListExclusion).write),
+		},
+		"import_list_imdb": {
+			resource:  NewImportListImdbResource,
+			roundTrip: ctxRoundTrip((*ImportListImdb).read, (*ImportListImdb).write),
+		},
+		"import_list_myanimelist": {
+			resource:  NewImportListMyAnimeListResource,
+			roundTrip: ctxRoundTrip((*ImportListMyAnimeList).read, (*ImportListMyAnimeList).write),
+		},
+		"import_list_plex": {
+			resource:  NewImportListPlexResource,
+			roundTrip: ctxRoundTrip((*ImportListPlex).read, (*ImportListPlex).write),
+		},
+		"import_list_plex_rss": {
+			resource:  NewImportListPlexRSSResource,
+			roundTrip: ctxRoundTrip((*ImportListPlexRSS).read, (*ImportListPlexRSS).write),
+		},
+		"import_list_simkl_user": {
+			resource:  NewImportListSimklUserResource,
+			roundTrip: ctxRoundTrip((*ImportListSimklUser).read, (*ImportListSimklUser).write),
+		},
+		"import_list_sonarr": {
+			resource:  NewImportListSonarrResource,
+			roundTrip: ctxRoundTrip((*ImportListSonarr).read, (*ImportListSonarr).write),
+		},
+		"import_list_trakt_list": {
+			resource:  NewImportListTraktListResource,
+			roundTrip: ctxRoundTrip((*ImportListTraktList).read, (*ImportListTraktList).write),
+		},
+		"import_list_trakt_popular": {
+			resource:  NewImportListTraktPopularResource,
+			roundTrip: ctxRoundTrip((*ImportListTraktPopular).read, (*ImportListTraktPopular).write),
+		},
+		"import_list_trakt_user": {
+			resource:  NewImportListTraktUserResource,
+			roundTrip: ctxRoundTrip((*ImportListTraktUser).read, (*ImportListTraktUser).write),
+		},
+		"indexer": {
+			resource:  NewIndexerResource,
+			roundTrip: ctxRoundTrip((*Indexer).read, (*Indexer).write),
+		},
+		"indexer_broadcasthenet": {
+			resource:  NewIndexerBroadcastheNetResource,
+			roundTrip: ctxRoundTrip((*IndexerBroadcastheNet).read, (*IndexerBroadcastheNet).write),
+		},
+		"indexer_config": {
+			resource:  NewIndexerConfigResource,
+			roundTrip: plainRoundTrip((*IndexerConfig).read, (*IndexerConfig).write),
+		},
+		"indexer_fanzub": {
+			resource:  NewIndexerFanzubResource,
+			roundTrip: ctxRoundTrip((*IndexerFanzub).read, (*IndexerFanzub).write),
+		},
+		"indexer_filelist": {
+			resource:  NewIndexerFilelistResource,
+			roundTrip: ctxRoundTrip((*IndexerFilelist).read, (*IndexerFilelist).write),
+		},
+		"indexer_hdbits": {
+			resource:  NewIndexerHdbitsResource,
+			roundTrip: ctxRoundTrip((*IndexerHdbits).read, (*IndexerHdbits).write),
+		},
+		"indexer_iptorrents": {
+			resource:  NewIndexerIptorrentsResource,
+			roundTrip: ctxRoundTrip((*IndexerIptorrents).read, (*IndexerIptorrents).write),
+		},
+		"indexer_newznab": {
+			resource:  NewIndexerNewznabResource,
+			roundTrip: ctxRoundTrip((*IndexerNewznab).read, (*IndexerNewznab).write),
+		},
+		"indexer_nyaa": {
+			resource:  NewIndexerNyaaResource,
+			roundTrip: ctxRoundTrip((*IndexerNyaa).read, (*IndexerNyaa).write),
+		},
+		"indexer_torrent_rss": {
+			resource:  NewIndexerTorrentRssResource,
+			roundTrip: ctxRoundTrip((*IndexerTorrentRss).read, (*IndexerTorrentRss).write),
+		},
+		"indexer_torrentleech": {
+			resource:  NewIndexerTorrentleechResource,
+			roundTrip: ctxRoundTrip((*IndexerTorrentleech).read, (*IndexerTorrentleech).write),
+		},
+		"indexer_torznab": {
+			resource:  NewIndexerTorznabResource,
+			roundTrip: ctxRoundTrip((*IndexerTorznab).read, (*IndexerTorznab).write),
+		},
+		"media_management": {
+			resource:  NewMediaManagementResource,
+			roundTrip: plainRoundTrip((*MediaManagement).read, (*MediaManagement).write),
+		},
+		"metadata": {
+			resource:  NewMetadataResource,
+			roundTrip: ctxRoundTrip((*Metadata).read, (*Metadata).write),
+		},
+		"metadata_emby": {
+			resource:  NewMetadataEmbyResource,
+			roundTrip: ctxRoundTrip((*MetadataEmby).read, (*MetadataEmby).write),
+		},
+		"metadata_kodi": {
+			resource:  NewMetadataKodiResource,
+			roundTrip: ctxRoundTrip((*MetadataKodi).read, (*MetadataKodi).write),
+		},
+		"metadata_plex": {
+			resource:  NewMetadataPlexResource,
+			roundTrip: ctxRoundTrip((*MetadataPlex).read, (*MetadataPlex).write),
+		},
+		"metadata_roksbox": {
+			resource:  NewMetadataRoksboxResource,
+			roundTrip: ctxRoundTrip((*MetadataRoksbox).read, (*MetadataRoksbox).write),
+		},
+		"metadata_wdtv": {
+			resource:  NewMetadataWdtvResource,
+			roundTrip: ctxRoundTrip((*MetadataWdtv).read, (*MetadataWdtv).write),
+		},
+		"naming": {
+			resource:  NewNamingResource,
+			roundTrip: plainRoundTrip((*Naming).read, (*Naming).write),
+		},
+		"notification": {
+			resource:  NewNotificationResource,
+			roundTrip: ctxRoundTrip((*Notification).read, (*Notification).write),
+		},
+		"notification_apprise": {
+			resource:  NewNotificationAppriseResource,
+			roundTrip: ctxRoundTrip((*NotificationApprise).read, (*NotificationApprise).write),
+		},
+		"notification_custom_script": {
+			resource:  NewNotificationCustomScriptResource,
+			roundTrip: ctxRoundTrip((*NotificationCustomScript).read, (*NotificationCustomScript).write),
+		},
+		"notification_discord": {
+			resource:  NewNotificationDiscordResource,
+			roundTrip: ctxRoundTrip((*NotificationDiscord).read, (*NotificationDiscord).write),
+		},
+		"notification_email": {
+			resource:  NewNotificationEmailResource,
+			roundTrip: ctxRoundTrip((*NotificationEmail).read, (*NotificationEmail).write),
+		},
+		"notification_emby": {
+			resource:  NewNotificationEmbyResource,
+			roundTrip: ctxRoundTrip((*NotificationEmby).read, (*NotificationEmby).write),
+		},
+		"notification_gotify": {
+			resource:  NewNotificationGotifyResource,
+			roundTrip: ctxRoundTrip((*NotificationGotify).read, (*NotificationGotify).write),
+		},
+		"notification_join": {
+			resource:  NewNotificationJoinResource,
+			roundTrip: ctxRoundTrip((*NotificationJoin).read, (*NotificationJoin).write),
+		},
+		"notification_kodi": {
+			resource:  NewNotificationKodiResource,
+			roundTrip: ctxRoundTrip((*NotificationKodi).read, (*NotificationKodi).write),
+		},
+		"notification_mailgun": {
+			resource:  NewNotificationMailgunResource,
+			roundTrip: ctxRoundTrip((*NotificationMailgun).read, (*NotificationMailgun).write),
+		},
+		"notification_notifiarr": {
+			resource:  NewNotificationNotifiarrResource,
+			roundTrip: ctxRoundTrip((*NotificationNotifiarr).read, (*NotificationNotifiarr).write),
+		},
+		"notification_ntfy": {
+			resource:  NewNotificationNtfyResource,
+			roundTrip: ctxRoundTrip((*NotificationNtfy).read, (*NotificationNtfy).write),
+		},
+		"notification_plex": {
+			resource:  NewNotificationPlexResource,
+			roundTrip: ctxRoundTrip((*NotificationPlex).read, (*NotificationPlex).write),
+		},
+		"notification_prowl": {
+			resource:  NewNotificationProwlResource,
+			roundTrip: ctxRoundTrip((*NotificationProwl).read, (*NotificationProwl).write),
+		},
+		"notification_pushbullet": {
+			resource:  NewNotificationPushbulletResource,
+			roundTrip: ctxRoundTrip((*NotificationPushbullet).read, (*NotificationPushbullet).write),
+		},
+		"notification_pushcut": {
+			resource:  NewNotificationPushcutResource,
+			roundTrip: ctxRoundTrip((*NotificationPushcut).read, (*NotificationPushcut).write),
+		},
+		"notification_pushover": {
+			resource:  NewNotificationPushoverResource,
+			roundTrip: ctxRoundTrip((*NotificationPushover).read, (*NotificationPushover).write),
+		},
+		"notification_sendgrid": {
+			resource:  NewNotificationSendgridResource,
+			roundTrip: ctxRoundTrip((*NotificationSendgrid).read, (*NotificationSendgrid).write),
+		},
+		"notification_signal": {
+			resource:  NewNotificationSignalResource,
+			roundTrip: ctxRoundTrip((*NotificationSignal).read, (*NotificationSignal).write),
+		},
+		"notification_simplepush": {
+			resource:  NewNotificationSimplepushResource,
+			roundTrip: ctxRoundTrip((*NotificationSimplepush).read, (*NotificationSimplepush).write),
+		},
+		"notification_slack": {
+			resource:  NewNotificationSlackResource,
+			roundTrip: ctxRoundTrip((*NotificationSlack).read, (*NotificationSlack).write),
+		},
+		"notification_synology_indexer": {
+			resource:  NewNotificationSynologyResource,
+			roundTrip: ctxRoundTrip((*NotificationSynology).read, (*NotificationSynology).write),
+		},
+		"notification_telegram": {
+			resource:  NewNotificationTelegramResource,
+			roundTrip: ctxRoundTrip((*NotificationTelegram).read, (*NotificationTelegram).write),
+		},
+		"notification_trakt": {
+			resource:  NewNotificationTraktResource,
+			roundTrip: ctxRoundTrip((*NotificationTrakt).read, (*NotificationTrakt).write),
+		},
+		"notification_twitter": {
+			resource:  NewNotificationTwitterResource,
+			roundTrip: ctxRoundTrip((*NotificationTwitter).read, (*NotificationTwitter).write),
+		},
+		"notification_webhook": {
+			resource:  NewNotificationWebhookResource,
+			roundTrip: ctxRoundTrip((*NotificationWebhook).read, (*NotificationWebhook).write),
+		},
+		"quality_definition": {
+			resource:  NewQualityDefinitionResource,
+			roundTrip: plainRoundTrip((*QualityDefinition).read, (*QualityDefinition).write),
+		},
+		"quality_profile": {
+			resource: NewQualityProfileResource,
+			roundTrip: ctxRoundTrip(func(p *QualityProfile, ctx context.Context, diags *diag.Diagnostics) *sonarr.QualityProfileResource {
+				return p.read(ctx, nil, nil, diags)
+			}, (*QualityProfile).write),
+		},
+		"release_profile": {
+			resource:  NewReleaseProfileResource,
+			roundTrip: ctxRoundTrip((*ReleaseProfile).read, (*ReleaseProfile).write),
+		},
+		"remote_path_mapping": {
+			resource:  NewRemotePathMappingResource,
+			roundTrip: plainRoundTrip((*RemotePathMapping).read, (*RemotePathMapping).write),
+		},
+		"series": {
+			resource:  NewSeriesResource,
+			roundTrip: ctxRoundTrip((*Series).read, (*Series).write),
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			input := testRoundTripState(ctx, test.resource(), test.values)
+			output := tfsdk.State{Schema: input.Schema, Raw: tftypes.NewValue(input.Raw.Type(), nil)}
+
+			var diags diag.Diagnostics
+
+			test.roundTrip(ctx, input, &output, &diags)
+			assert.False(t, diags.HasError(), diags)
+
+			var expected, actual map[string]tftypes.Value
+
+			assert.NoError(t, input.Raw.As(&expected))
+			assert.NoError(t, output.Raw.As(&actual))
+
+			for attribute, value := range expected {
+				if !slices.Contains(resourceOnlyAttributes, attribute) && !slices.Contains(roundTripAlternatives, attribute) {
+					assert.True(t, value.Equal(actual[attribute]), "attribute %s: expected %s, got %s", attribute, value, actual[attribute])
+				}
+			}
+		})
+	}
+}
+
+func TestFieldsMapping(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		fields          helpers.Fields
+		model           interface{}
+		implementations []interface{}
+	}{
+		"download_client": {
+			fields:          downloadClientFields,
+			model:           &DownloadClient{},
+			implementations: []interface{}{&DownloadClientAria2{}, &DownloadClientDeluge{}, &DownloadClientFlood{}, &DownloadClientFreebox{}, &DownloadClientHadouken{}, &DownloadClientNzbget{}, &DownloadClientNzbvortex{}, &DownloadClientPneumatic{}, &DownloadClientQbittorrent{}, &DownloadClientRtorrent{}, &DownloadClientSabnzbd{}, &DownloadClientTorrentBlackhole{}, &DownloadClientTorrentDownloadStation{}, &DownloadClientTransmission{}, &DownloadClientUsenetBlackhole{}, &DownloadClientUsenetDownloadStation{}, &DownloadClientUtorrent{}, &DownloadClientVuze{}},
+		},
+		"indexer": {
+			fields:          indexerFields,
+			model:           &Indexer{},
+			implementations: []interface{}{&IndexerBroadcastheNet{}, &IndexerFanzub{}, &IndexerFilelist{}, &IndexerHdbits{}, &IndexerIptorrents{}, &IndexerNewznab{}, &IndexerNyaa{}, &IndexerTorrentRss{}, &IndexerTorrentleech{}, &IndexerTorznab{}},
+		},
+		"notification": {
+			fields:          notificationFields,
+			model:           &Notification{},
+			implementations: []interface{}{&NotificationApprise{}, &NotificationCustomScript{}, &NotificationDiscord{}, &NotificationEmail{}, &NotificationEmby{}, &NotificationGotify{}, &NotificationJoin{}, &NotificationKodi{}, &NotificationMailgun{}, &NotificationNotifiarr{}, &NotificationNtfy{}, &NotificationPlex{}, &NotificationProwl{}, &NotificationPushbullet{}, &NotificationPushcut{}, &NotificationPushover{}, &NotificationSendgrid{}, &NotificationSignal{}, &NotificationSimplepush{}, &NotificationSlack{}, &NotificationSynology{}, &NotificationTelegram{}, &NotificationTrakt{}, &NotificationTwitter{}, &NotificationWebhook{}},
+		},
+		"import_list": {
+			fields:          importListFields,
+			model:           &ImportList{},
+			implementations: []interface{}{&ImportListAniList{}, &ImportListCustom{}, &ImportListImdb{}, &ImportListMyAnimeList{}, &ImportListPlex{}, &ImportListPlexRSS{}, &ImportListSimklUser{}, &ImportListSonarr{}, &ImportListTraktList{}, &ImportListTraktPopular{}, &ImportListTraktUser{}},
+		},
+		"metadata": {
+			fields:          metadataFields,
+			model:           &Metadata{},
+			implementations: []interface{}{&MetadataEmby{}, &MetadataKodi{}, &MetadataPlex{}, &MetadataRoksbox{}, &MetadataWdtv{}},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			// every field must be managed by an attribute of the generic model
+			lists := reflect.ValueOf(test.fields)
+			for i := range lists.NumField() {
+				for _, field := range lists.Field(i).Interface().([]string) {
+					assert.NotEmpty(t, helpers.AttributeName(field, test.model, test.fields), "field %s not found", field)
+				}
+			}
+
+			// every implementation attribute must be carried by the generic model
+			for _, implementation := range test.implementations {
+				implementationType := reflect.TypeOf(implementation).Elem()
+				for i := range implementationType.NumField() {
+					field := implementationType.Field(i)
+					assert.NotEmpty(t, helpers.StructAttributeName(field.Name, test.model), "%s attribute %s not found", implementationType.Name(), field.Tag.Get("tfsdk"))
+				}
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -21,8 +22,27 @@ const namingResourceName = "naming"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NamingResource{}
-	_ resource.ResourceWithImportState = &NamingResource{}
+	_ resource.Resource                     = &NamingResource{}
+	_ resource.ResourceWithImportState      = &NamingResource{}
+	_ resource.ResourceWithConfigValidators = &NamingResource{}
+)
+
+var (
+	namingMultiEpisodeStyles = intEnum{
+		0: "extend",
+		1: "duplicate",
+		2: "repeat",
+		3: "scene",
+		4: "range",
+		5: "prefixed_range",
+	}
+	namingColonReplacementFormats = intEnum{
+		0: "delete",
+		1: "dash",
+		2: "space_dash",
+		3: "space_dash_space",
+		4: "smart",
+	}
 )
 
 func NewNamingResource() resource.Resource {
@@ -37,17 +57,19 @@ type NamingResource struct {
 
 // Naming describes the naming data model.
 type Naming struct {
-	DailyEpisodeFormat       types.String `tfsdk:"daily_episode_format"`
-	AnimeEpisodeFormat       types.String `tfsdk:"anime_episode_format"`
-	SeriesFolderFormat       types.String `tfsdk:"series_folder_format"`
-	SeasonFolderFormat       types.String `tfsdk:"season_folder_format"`
-	SpecialsFolderFormat     types.String `tfsdk:"specials_folder_format"`
-	StandardEpisodeFormat    types.String `tfsdk:"standard_episode_format"`
-	ID                       types.Int64  `tfsdk:"id"`
-	MultiEpisodeStyle        types.Int64  `tfsdk:"multi_episode_style"`
-	ColonReplacementFormat   types.Int64  `tfsdk:"colon_replacement_format"`
-	RenameEpisodes           types.Bool   `tfsdk:"rename_episodes"`
-	ReplaceIllegalCharacters types.Bool   `tfsdk:"replace_illegal_characters"`
+	DailyEpisodeFormat         types.String `tfsdk:"daily_episode_format"`
+	AnimeEpisodeFormat         types.String `tfsdk:"anime_episode_format"`
+	SeriesFolderFormat         types.String `tfsdk:"series_folder_format"`
+	SeasonFolderFormat         types.String `tfsdk:"season_folder_format"`
+	SpecialsFolderFormat       types.String `tfsdk:"specials_folder_format"`
+	StandardEpisodeFormat      types.String `tfsdk:"standard_episode_format"`
+	MultiEpisodeStyleName      types.String `tfsdk:"multi_episode_style_name"`
+	ColonReplacementFormatName types.String `tfsdk:"colon_replacement_format_name"`
+	ID                         types.Int64  `tfsdk:"id"`
+	MultiEpisodeStyle          types.Int64  `tfsdk:"multi_episode_style"`
+	ColonReplacementFormat     types.Int64  `tfsdk:"colon_replacement_format"`
+	RenameEpisodes             types.Bool   `tfsdk:"rename_episodes"`
+	ReplaceIllegalCharacters   types.Bool   `tfsdk:"replace_illegal_characters"`
 }
 
 func (r *NamingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -75,18 +97,24 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			"multi_episode_style": schema.Int64Attribute{
 				MarkdownDescription: "Multi episode style. 0 - 'Extend' 1 - 'Duplicate' 2 - 'Repeat' 3 - 'Scene' 4 - 'Range' 5 - 'Prefixed Range'.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("multi_episode_style_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3, 4, 5),
 				},
 			},
+			"multi_episode_style_name": intEnumAttribute("Multi episode style.", "multi_episode_style", namingMultiEpisodeStyles),
 			"colon_replacement_format": schema.Int64Attribute{
 				MarkdownDescription: "Colon replacement format. 0 - 'Delete' 1 - 'Replace with Dash' 2 - 'Replace with Space Dash' 3 - 'Replace with Space Dash Space' 4 - 'Smart Replace'.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("colon_replacement_format_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3, 4),
 				},
 			},
+			"colon_replacement_format_name": intEnumAttribute("Colon replacement format.", "colon_replacement_format", namingColonReplacementFormats),
 			"daily_episode_format": schema.StringAttribute{
 				MarkdownDescription: "Daily episode format.",
 				Required:            true,
@@ -115,6 +143,19 @@ func (r *NamingResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 	}
 }
 
+func (r *NamingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("multi_episode_style"),
+			path.MatchRoot("multi_episode_style_name"),
+		),
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("colon_replacement_format"),
+			path.MatchRoot("colon_replacement_format_name"),
+		),
+	}
+}
+
 func (r *NamingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -225,6 +266,8 @@ func (n *Naming) write(naming *sonarr.NamingConfigResource) {
 	n.ID = types.Int64Value(int64(naming.GetId()))
 	n.MultiEpisodeStyle = types.Int64Value(int64(naming.GetMultiEpisodeStyle()))
 	n.ColonReplacementFormat = types.Int64Value(int64(naming.GetColonReplacementFormat()))
+	n.MultiEpisodeStyleName = namingMultiEpisodeStyles.name(n.MultiEpisodeStyle)
+	n.ColonReplacementFormatName = namingColonReplacementFormats.name(n.ColonReplacementFormat)
 	n.DailyEpisodeFormat = types.StringValue(naming.GetDailyEpisodeFormat())
 	n.AnimeEpisodeFormat = types.StringValue(naming.GetAnimeEpisodeFormat())
 	n.SeriesFolderFormat = types.StringValue(naming.GetSeriesFolderFormat())
@@ -240,8 +283,8 @@ func (n *Naming) read() *sonarr.NamingConfigResource {
 	naming.SetId(int32(n.ID.ValueInt64()))
 	naming.SetRenameEpisodes(n.RenameEpisodes.ValueBool())
 	naming.SetReplaceIllegalCharacters(n.ReplaceIllegalCharacters.ValueBool())
-	naming.SetMultiEpisodeStyle(int32(n.MultiEpisodeStyle.ValueInt64()))
-	naming.SetColonReplacementFormat(int32(n.ColonReplacementFormat.ValueInt64()))
+	naming.SetMultiEpisodeStyle(int32(namingMultiEpisodeStyles.value(n.MultiEpisodeStyleName, n.MultiEpisodeStyle).ValueInt64()))
+	naming.SetColonReplacementFormat(int32(namingColonReplacementFormats.value(n.ColonReplacementFormatName, n.ColonReplacementFormat).ValueInt64()))
 	naming.SetSeriesFolderFormat(n.SeriesFolderFormat.ValueString())
 	naming.SetSeasonFolderFormat(n.SeasonFolderFormat.ValueString())
 	naming.SetSpecialsFolderFormat(n.SpecialsFolderFormat.ValueString())
@@ -25,6 +25,7 @@ func TestAccNamingResource(t *testing.T) {
 				Config: testAccNamingResourceConfig("Specials"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_naming.test", "specials_folder_format", "Specials"),
+					resource.TestCheckResourceAttr("sonarr_naming.test", "colon_replacement_format", "4"),
 					resource.TestCheckResourceAttrSet("sonarr_naming.test", "id"),
 				),
 			},
@@ -54,15 +55,15 @@ func TestAccNamingResource(t *testing.T) {
 func testAccNamingResourceConfig(specials string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_naming" "test" {
-		rename_episodes            = true
-		replace_illegal_characters = true
-		multi_episode_style        = 0
-		colon_replacement_format   = 4
-		daily_episode_format       = "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}"
-		anime_episode_format       = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
-		series_folder_format       = "{Series Title}"
-		season_folder_format       = "Season {season}"
-		specials_folder_format     = "%s"
-		standard_episode_format    = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
+		rename_episodes               = true
+		replace_illegal_characters    = true
+		multi_episode_style_name      = "extend"
+		colon_replacement_format_name = "smart"
+		daily_episode_format          = "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}"
+		anime_episode_format          = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
+		series_folder_format          = "{Series Title}"
+		season_folder_format          = "Season {season}"
+		specials_folder_format        = "%s"
+		standard_episode_format       = "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}"
 	}`, specials)
 }
@@ -24,6 +24,13 @@ const (
 	notificationAppriseConfigContract = "AppriseSettings"
 )
 
+var notificationAppriseTypes = intEnum{
+	0: "info",
+	1: "success",
+	2: "warning",
+	3: "failure",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &NotificationAppriseResource{}
@@ -36,8 +43,10 @@ func NewNotificationAppriseResource() resource.Resource {
 
 // NotificationAppriseResource defines the notification implementation.
 type NotificationAppriseResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // NotificationApprise describes the notification data model.
@@ -50,8 +59,10 @@ type NotificationApprise struct {
 	AuthUsername                  types.String `tfsdk:"auth_username"`
 	AuthPassword                  types.String `tfsdk:"auth_password"`
 	ConfigurationKey              types.String `tfsdk:"configuration_key"`
+	NotificationTypeName          types.String `tfsdk:"notification_type_name"`
 	NotificationType              types.Int64  `tfsdk:"notification_type"`
 	ID                            types.Int64  `tfsdk:"id"`
+	SecretsRevision               types.Int64  `tfsdk:"secrets_revision"`
 	OnGrab                        types.Bool   `tfsdk:"on_grab"`
 	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
 	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
@@ -65,6 +76,7 @@ type NotificationApprise struct {
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
 	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationApprise) toNotification() *Notification {
@@ -77,6 +89,7 @@ func (n NotificationApprise) toNotification() *Notification {
 		AuthPassword:                  n.AuthPassword,
 		ConfigurationKey:              n.ConfigurationKey,
 		NotificationType:              n.NotificationType,
+		NotificationTypeName:          n.NotificationTypeName,
 		Name:                          n.Name,
 		ID:                            n.ID,
 		OnGrab:                        n.OnGrab,
@@ -106,6 +119,7 @@ func (n *NotificationApprise) fromNotification(notification *Notification) {
 	n.AuthPassword = notification.AuthPassword
 	n.ConfigurationKey = notification.ConfigurationKey
 	n.NotificationType = notification.NotificationType
+	n.NotificationTypeName = notification.NotificationTypeName
 	n.Name = notification.Name
 	n.ID = notification.ID
 	n.OnGrab = notification.OnGrab
@@ -206,6 +220,8 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -218,13 +234,18 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("notification_type_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3),
 				},
 			},
+			"notification_type_name": intEnumAttribute("Notification type.", "notification_type", notificationAppriseTypes),
 			"server_url": schema.StringAttribute{
 				MarkdownDescription: "Server URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"stateless_urls": schema.StringAttribute{
 				MarkdownDescription: "Stateless URLs.",
@@ -259,9 +280,11 @@ func (r *NotificationAppriseResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationAppriseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -278,7 +301,11 @@ func (r *NotificationAppriseResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationAppriseResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationAppriseResourceName, err))
 
@@ -328,6 +355,10 @@ func (r *NotificationAppriseResource) Update(ctx context.Context, req resource.U
 	// Update NotificationApprise
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationAppriseResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationAppriseResourceName, err))
@@ -363,7 +394,7 @@ func (r *NotificationAppriseResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationAppriseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationAppriseResourceName, findNotificationIDs(r.auth, r.client, notificationAppriseImplementation))
 	tflog.Trace(ctx, "imported "+notificationAppriseResourceName+": "+req.ID)
 }
 
@@ -67,7 +67,7 @@ func testAccNotificationAppriseResourceConfig(name, token string) string {
 		include_health_warnings = false
 		name                    = "%s"
 	  
-		notification_type = 1
+		notification_type_name = "success"
 		server_url = "https://apprise.go"
 		auth_username = "User"
 		auth_password = "%s"
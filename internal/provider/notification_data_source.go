@@ -36,6 +36,18 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		// This description is used by the documentation generator and the delay server.
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nSingle [Notification](../resources/notification).",
 		Attributes: map[string]schema.Attribute{
+			"secrets_revision": schema.Int64Attribute{
+				MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+				Computed:            true,
+			},
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+				Computed:            true,
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Computed:            true,
@@ -134,6 +146,10 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Add silently flag.",
 				Computed:            true,
 			},
+			"time_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Time sensitive flag.",
+				Computed:            true,
+			},
 			"update_library": schema.BoolAttribute{
 				MarkdownDescription: "Update library flag.",
 				Computed:            true,
@@ -174,10 +190,18 @@ func (d *NotificationDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
 				Computed:            true,
 			},
+			"notification_type_name": schema.StringAttribute{
+				MarkdownDescription: "Notification type. Allowed values: " + notificationAppriseTypes.description() + ".",
+				Computed:            true,
+			},
 			"stateless_urls": schema.StringAttribute{
 				MarkdownDescription: "Stateless URLs.",
 				Computed:            true,
 			},
+			"notification_name": schema.StringAttribute{
+				MarkdownDescription: "Notification name.",
+				Computed:            true,
+			},
 			"configuration_key": schema.StringAttribute{
 				MarkdownDescription: "Configuration key.",
 				Computed:            true,
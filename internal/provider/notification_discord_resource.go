@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,8 +35,10 @@ func NewNotificationDiscordResource() resource.Resource {
 
 // NotificationDiscordResource defines the notification implementation.
 type NotificationDiscordResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // NotificationDiscord describes the notification data model.
@@ -63,6 +66,7 @@ type NotificationDiscord struct {
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
 	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationDiscord) toNotification() *Notification {
@@ -209,6 +213,7 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"test_on_apply": testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -220,6 +225,9 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 			"web_hook_url": schema.StringAttribute{
 				MarkdownDescription: "Web hook URL.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"username": schema.StringAttribute{
 				MarkdownDescription: "Username.",
@@ -253,9 +261,11 @@ func (r *NotificationDiscordResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NotificationDiscordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -272,7 +282,11 @@ func (r *NotificationDiscordResource) Create(ctx context.Context, req resource.C
 	// Create new NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationDiscordResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationDiscordResourceName, err))
 
@@ -322,6 +336,10 @@ func (r *NotificationDiscordResource) Update(ctx context.Context, req resource.U
 	// Update NotificationDiscord
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationDiscordResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationDiscordResourceName, err))
@@ -357,7 +375,7 @@ func (r *NotificationDiscordResource) Delete(ctx context.Context, req resource.D
 }
 
 func (r *NotificationDiscordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationDiscordResourceName, findNotificationIDs(r.auth, r.client, notificationDiscordImplementation))
 	tflog.Trace(ctx, "imported "+notificationDiscordResourceName+": "+req.ID)
 }
 
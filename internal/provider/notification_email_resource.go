@@ -36,8 +36,10 @@ func NewNotificationEmailResource() resource.Resource {
 
 // NotificationEmailResource defines the notification implementation.
 type NotificationEmailResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // NotificationEmail describes the notification data model.
@@ -54,6 +56,7 @@ type NotificationEmail struct {
 	ID                            types.Int64  `tfsdk:"id"`
 	Port                          types.Int64  `tfsdk:"port"`
 	UseEncryption                 types.Int64  `tfsdk:"use_encryption"`
+	SecretsRevision               types.Int64  `tfsdk:"secrets_revision"`
 	OnGrab                        types.Bool   `tfsdk:"on_grab"`
 	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
 	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
@@ -67,6 +70,7 @@ type NotificationEmail struct {
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
 	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmail) toNotification() *Notification {
@@ -212,6 +216,8 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -232,6 +238,9 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"server": schema.StringAttribute{
 				MarkdownDescription: "Server.",
@@ -274,9 +283,11 @@ func (r *NotificationEmailResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *NotificationEmailResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -293,7 +304,11 @@ func (r *NotificationEmailResource) Create(ctx context.Context, req resource.Cre
 	// Create new NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationEmailResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmailResourceName, err))
 
@@ -343,6 +358,10 @@ func (r *NotificationEmailResource) Update(ctx context.Context, req resource.Upd
 	// Update NotificationEmail
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationEmailResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmailResourceName, err))
@@ -378,7 +397,7 @@ func (r *NotificationEmailResource) Delete(ctx context.Context, req resource.Del
 }
 
 func (r *NotificationEmailResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationEmailResourceName, findNotificationIDs(r.auth, r.client, notificationEmailImplementation))
 	tflog.Trace(ctx, "imported "+notificationEmailResourceName+": "+req.ID)
 }
 
@@ -6,12 +6,14 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -34,8 +36,10 @@ func NewNotificationEmbyResource() resource.Resource {
 
 // NotificationEmbyResource defines the notification implementation.
 type NotificationEmbyResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // NotificationEmby describes the notification data model.
@@ -46,6 +50,7 @@ type NotificationEmby struct {
 	Name                          types.String `tfsdk:"name"`
 	ID                            types.Int64  `tfsdk:"id"`
 	Port                          types.Int64  `tfsdk:"port"`
+	SecretsRevision               types.Int64  `tfsdk:"secrets_revision"`
 	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
 	Notify                        types.Bool   `tfsdk:"notify"`
 	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
@@ -62,6 +67,7 @@ type NotificationEmby struct {
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
 	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n NotificationEmby) toNotification() *Notification {
@@ -201,6 +207,8 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -228,6 +236,9 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key.",
@@ -237,15 +248,20 @@ func (r *NotificationEmbyResource) Schema(_ context.Context, _ resource.SchemaRe
 			"host": schema.StringAttribute{
 				MarkdownDescription: "Host.",
 				Required:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 		},
 	}
 }
 
 func (r *NotificationEmbyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -262,7 +278,11 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationEmbyResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
 
@@ -312,6 +332,10 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationEmbyResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
@@ -347,7 +371,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 }
 
 func (r *NotificationEmbyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationEmbyResourceName, findNotificationIDs(r.auth, r.client, notificationEmbyImplementation))
 	tflog.Trace(ctx, "imported "+notificationEmbyResourceName+": "+req.ID)
 }
 
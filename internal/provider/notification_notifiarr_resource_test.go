@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationNotifiarrResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccNotificationNotifiarrResourceConfig("resourceNotifiarrTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationNotifiarrResourceConfig("resourceNotifiarrTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_notification_notifiarr.test", "on_grab", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_notification_notifiarr.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccNotificationNotifiarrResourceConfig("resourceNotifiarrTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationNotifiarrResourceConfig("resourceNotifiarrTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_notification_notifiarr.test", "on_grab", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_notification_notifiarr.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationNotifiarrResourceConfig(name, grab string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_notification_notifiarr" "test" {
+		on_grab                            = %s
+		on_download                        = false
+		on_upgrade                         = false
+		on_series_delete                   = false
+		on_episode_file_delete             = false
+		on_episode_file_delete_for_upgrade = false
+		on_health_issue                    = false
+		on_application_update              = false
+		on_rename                          = false
+	  
+		include_health_warnings = false
+		name                    = "%s"
+	  
+		api_key = "Key"
+	}`, grab, name)
+}
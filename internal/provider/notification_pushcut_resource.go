@@ -0,0 +1,354 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	notificationPushcutResourceName   = "notification_pushcut"
+	notificationPushcutImplementation = "Pushcut"
+	notificationPushcutConfigContract = "PushcutSettings"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &NotificationPushcutResource{}
+	_ resource.ResourceWithImportState = &NotificationPushcutResource{}
+)
+
+func NewNotificationPushcutResource() resource.Resource {
+	return &NotificationPushcutResource{}
+}
+
+// NotificationPushcutResource defines the notification implementation.
+type NotificationPushcutResource struct {
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
+}
+
+// NotificationPushcut describes the notification data model.
+type NotificationPushcut struct {
+	Tags                          types.Set    `tfsdk:"tags"`
+	Name                          types.String `tfsdk:"name"`
+	NotificationName              types.String `tfsdk:"notification_name"`
+	APIKey                        types.String `tfsdk:"api_key"`
+	ID                            types.Int64  `tfsdk:"id"`
+	SecretsRevision               types.Int64  `tfsdk:"secrets_revision"`
+	OnGrab                        types.Bool   `tfsdk:"on_grab"`
+	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
+	OnEpisodeFileDelete           types.Bool   `tfsdk:"on_episode_file_delete"`
+	IncludeHealthWarnings         types.Bool   `tfsdk:"include_health_warnings"`
+	OnApplicationUpdate           types.Bool   `tfsdk:"on_application_update"`
+	OnHealthIssue                 types.Bool   `tfsdk:"on_health_issue"`
+	OnHealthRestored              types.Bool   `tfsdk:"on_health_restored"`
+	OnManualInteractionRequired   types.Bool   `tfsdk:"on_manual_interaction_required"`
+	OnSeriesAdd                   types.Bool   `tfsdk:"on_series_add"`
+	OnSeriesDelete                types.Bool   `tfsdk:"on_series_delete"`
+	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
+	OnDownload                    types.Bool   `tfsdk:"on_download"`
+	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TimeSensitive                 types.Bool   `tfsdk:"time_sensitive"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
+}
+
+func (n NotificationPushcut) toNotification() *Notification {
+	return &Notification{
+		Tags:                          n.Tags,
+		NotificationName:              n.NotificationName,
+		APIKey:                        n.APIKey,
+		TimeSensitive:                 n.TimeSensitive,
+		Name:                          n.Name,
+		ID:                            n.ID,
+		OnGrab:                        n.OnGrab,
+		OnEpisodeFileDeleteForUpgrade: n.OnEpisodeFileDeleteForUpgrade,
+		OnEpisodeFileDelete:           n.OnEpisodeFileDelete,
+		IncludeHealthWarnings:         n.IncludeHealthWarnings,
+		OnApplicationUpdate:           n.OnApplicationUpdate,
+		OnHealthIssue:                 n.OnHealthIssue,
+		OnHealthRestored:              n.OnHealthRestored,
+		OnManualInteractionRequired:   n.OnManualInteractionRequired,
+		OnSeriesAdd:                   n.OnSeriesAdd,
+		OnSeriesDelete:                n.OnSeriesDelete,
+		OnUpgrade:                     n.OnUpgrade,
+		OnDownload:                    n.OnDownload,
+		OnImportComplete:              n.OnImportComplete,
+		ConfigContract:                types.StringValue(notificationPushcutConfigContract),
+		Implementation:                types.StringValue(notificationPushcutImplementation),
+	}
+}
+
+func (n *NotificationPushcut) fromNotification(notification *Notification) {
+	n.Tags = notification.Tags
+	n.NotificationName = notification.NotificationName
+	n.APIKey = notification.APIKey
+	n.TimeSensitive = notification.TimeSensitive
+	n.Name = notification.Name
+	n.ID = notification.ID
+	n.OnGrab = notification.OnGrab
+	n.OnEpisodeFileDeleteForUpgrade = notification.OnEpisodeFileDeleteForUpgrade
+	n.OnEpisodeFileDelete = notification.OnEpisodeFileDelete
+	n.IncludeHealthWarnings = notification.IncludeHealthWarnings
+	n.OnApplicationUpdate = notification.OnApplicationUpdate
+	n.OnHealthIssue = notification.OnHealthIssue
+	n.OnHealthRestored = notification.OnHealthRestored
+	n.OnManualInteractionRequired = notification.OnManualInteractionRequired
+	n.OnSeriesAdd = notification.OnSeriesAdd
+	n.OnSeriesDelete = notification.OnSeriesDelete
+	n.OnUpgrade = notification.OnUpgrade
+	n.OnDownload = notification.OnDownload
+	n.OnImportComplete = notification.OnImportComplete
+}
+
+func (r *NotificationPushcutResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + notificationPushcutResourceName
+}
+
+func (r *NotificationPushcutResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Notifications -->\nNotification Pushcut resource.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect) and [Pushcut](https://wiki.servarr.com/sonarr/supported#pushcut).",
+		Attributes: map[string]schema.Attribute{
+			"on_grab": schema.BoolAttribute{
+				MarkdownDescription: "On grab flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_download": schema.BoolAttribute{
+				MarkdownDescription: "On download flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_import_complete": schema.BoolAttribute{
+				MarkdownDescription: "On import complete flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_upgrade": schema.BoolAttribute{
+				MarkdownDescription: "On upgrade flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_series_add": schema.BoolAttribute{
+				MarkdownDescription: "On series add flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_series_delete": schema.BoolAttribute{
+				MarkdownDescription: "On series delete flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_episode_file_delete": schema.BoolAttribute{
+				MarkdownDescription: "On episode file delete flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_episode_file_delete_for_upgrade": schema.BoolAttribute{
+				MarkdownDescription: "On episode file delete for upgrade flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_health_issue": schema.BoolAttribute{
+				MarkdownDescription: "On health issue flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_health_restored": schema.BoolAttribute{
+				MarkdownDescription: "On health restored flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_manual_interaction_required": schema.BoolAttribute{
+				MarkdownDescription: "On manual interaction required flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"on_application_update": schema.BoolAttribute{
+				MarkdownDescription: "On application update flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"include_health_warnings": schema.BoolAttribute{
+				MarkdownDescription: "Include health warnings.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "NotificationPushcut name.",
+				Required:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "List of associated tags.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Notification ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			// Field values
+			"notification_name": schema.StringAttribute{
+				MarkdownDescription: "Notification name.",
+				Required:            true,
+			},
+			"api_key": schema.StringAttribute{
+				MarkdownDescription: "API key.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"time_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Time sensitive flag.",
+				Optional:            true,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *NotificationPushcutResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
+	}
+}
+
+func (r *NotificationPushcutResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var notification *NotificationPushcut
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new NotificationPushcut
+	request := notification.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationPushcutResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationPushcutResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "created "+notificationPushcutResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+}
+
+func (r *NotificationPushcutResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var notification *NotificationPushcut
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &notification)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get NotificationPushcut current value
+	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationPushcutResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+notificationPushcutResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+}
+
+func (r *NotificationPushcutResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var notification *NotificationPushcut
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &notification)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update NotificationPushcut
+	request := notification.read(ctx, &resp.Diagnostics)
+
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationPushcutResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationPushcutResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+notificationPushcutResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	notification.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &notification)...)
+}
+
+func (r *NotificationPushcutResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete NotificationPushcut current value
+	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationPushcutResourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+notificationPushcutResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *NotificationPushcutResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationPushcutResourceName, findNotificationIDs(r.auth, r.client, notificationPushcutImplementation))
+	tflog.Trace(ctx, "imported "+notificationPushcutResourceName+": "+req.ID)
+}
+
+func (n *NotificationPushcut) write(ctx context.Context, notification *sonarr.NotificationResource, diags *diag.Diagnostics) {
+	genericNotification := n.toNotification()
+	genericNotification.write(ctx, notification, diags)
+	n.fromNotification(genericNotification)
+}
+
+func (n *NotificationPushcut) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
+	return n.toNotification().read(ctx, diags)
+}
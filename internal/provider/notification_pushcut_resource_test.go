@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationPushcutResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccNotificationPushcutResourceConfig("resourcePushcutTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccNotificationPushcutResourceConfig("resourcePushcutTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_notification_pushcut.test", "time_sensitive", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_notification_pushcut.test", "id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccNotificationPushcutResourceConfig("resourcePushcutTest", "false") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccNotificationPushcutResourceConfig("resourcePushcutTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_notification_pushcut.test", "time_sensitive", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_notification_pushcut.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccNotificationPushcutResourceConfig(name, timeSensitive string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_notification_pushcut" "test" {
+		on_grab                            = false
+		on_download                        = false
+		on_upgrade                         = false
+		on_series_delete                   = false
+		on_episode_file_delete             = false
+		on_episode_file_delete_for_upgrade = false
+		on_health_issue                    = false
+		on_application_update              = false
+	  
+		include_health_warnings = false
+		name                    = "%s"
+	  
+		notification_name = "Sonarr"
+		api_key = "Key"
+		time_sensitive = %s
+	}`, name, timeSensitive)
+}
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -23,13 +24,37 @@ const notificationResourceName = "notification"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &NotificationResource{}
-	_ resource.ResourceWithImportState = &NotificationResource{}
+	_ resource.Resource                   = &NotificationResource{}
+	_ resource.ResourceWithImportState    = &NotificationResource{}
+	_ resource.ResourceWithValidateConfig = &NotificationResource{}
 )
 
+// notificationUnsupportedEvents lists by implementation the events not supported by Sonarr.
+var notificationUnsupportedEvents = map[string][]string{
+	notificationAppriseImplementation:    {"on_rename"},
+	notificationEmailImplementation:      {"on_rename"},
+	notificationEmbyImplementation:       {"on_manual_interaction_required"},
+	notificationGotifyImplementation:     {"on_rename"},
+	notificationJoinImplementation:       {"on_rename"},
+	notificationMailgunImplementation:    {"on_rename"},
+	notificationNtfyImplementation:       {"on_rename"},
+	notificationPlexImplementation:       {"on_application_update", "on_grab", "on_health_issue", "on_health_restored", "on_manual_interaction_required"},
+	notificationProwlImplementation:      {"on_rename"},
+	notificationPushbulletImplementation: {"on_rename"},
+	notificationPushcutImplementation:    {"on_rename"},
+	notificationPushoverImplementation:   {"on_rename"},
+	notificationSendgridImplementation:   {"on_rename"},
+	notificationSignalImplementation:     {"on_rename"},
+	notificationSimplepushImplementation: {"on_rename"},
+	notificationSynologyImplementation:   {"on_application_update", "on_grab", "on_health_issue", "on_health_restored", "on_manual_interaction_required"},
+	notificationTelegramImplementation:   {"on_rename"},
+	notificationTraktImplementation:      {"on_application_update", "on_grab", "on_health_issue", "on_health_restored", "on_manual_interaction_required", "on_rename"},
+	notificationTwitterImplementation:    {"on_rename"},
+}
+
 var notificationFields = helpers.Fields{
-	Bools:                  []string{"alwaysUpdate", "cleanLibrary", "directMessage", "notify", "sendSilently", "updateLibrary", "useEuEndpoint", "useSsl"},
-	Strings:                []string{"accessToken", "accessTokenSecret", "apiKey", "appToken", "arguments", "author", "authToken", "authUser", "avatar", "botToken", "channel", "chatId", "consumerKey", "consumerSecret", "deviceNames", "expires", "from", "host", "icon", "mention", "password", "path", "refreshToken", "senderDomain", "senderId", "server", "signIn", "sound", "token", "url", "userKey", "username", "userName", "webHookUrl", "clickUrl", "serverUrl", "authUsername", "authPassword", "statelessUrls", "configurationKey", "senderNumber", "receiverId", "key", "event"},
+	Bools:                  []string{"alwaysUpdate", "cleanLibrary", "directMessage", "notify", "sendSilently", "timeSensitive", "updateLibrary", "useEuEndpoint", "useSsl"},
+	Strings:                []string{"accessToken", "accessTokenSecret", "apiKey", "appToken", "arguments", "author", "authToken", "authUser", "avatar", "botToken", "channel", "chatId", "consumerKey", "consumerSecret", "deviceNames", "expires", "from", "host", "icon", "mention", "password", "path", "refreshToken", "senderDomain", "senderId", "server", "signIn", "sound", "token", "url", "userKey", "username", "userName", "webHookUrl", "clickUrl", "serverUrl", "authUsername", "authPassword", "statelessUrls", "configurationKey", "senderNumber", "receiverId", "key", "event", "notificationName"},
 	Ints:                   []string{"method", "port", "priority", "retry", "expire", "displayTime", "notificationType", "useEncryption"},
 	StringSlices:           []string{"channelTags", "deviceIds", "devices", "recipients", "to", "cc", "bcc", "topics", "fieldTags"},
 	StringSlicesExceptions: []string{"tags"},
@@ -42,8 +67,10 @@ func NewNotificationResource() resource.Resource {
 
 // NotificationResource defines the notification implementation.
 type NotificationResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
+	testOnApply   bool
 }
 
 // Notification describes the notification data model.
@@ -60,6 +87,7 @@ type Notification struct {
 	To                            types.Set    `tfsdk:"to"`
 	Cc                            types.Set    `tfsdk:"cc"`
 	Bcc                           types.Set    `tfsdk:"bcc"`
+	ExtraFields                   types.String `tfsdk:"extra_fields"`
 	Path                          types.String `tfsdk:"path"`
 	RefreshToken                  types.String `tfsdk:"refresh_token"`
 	WebHookURL                    types.String `tfsdk:"web_hook_url"`
@@ -104,8 +132,10 @@ type Notification struct {
 	AuthUsername                  types.String `tfsdk:"auth_username"`
 	AuthPassword                  types.String `tfsdk:"auth_password"`
 	ConfigurationKey              types.String `tfsdk:"configuration_key"`
+	NotificationTypeName          types.String `tfsdk:"notification_type_name"`
 	Key                           types.String `tfsdk:"key"`
 	Event                         types.String `tfsdk:"event"`
+	NotificationName              types.String `tfsdk:"notification_name"`
 	NotificationType              types.Int64  `tfsdk:"notification_type"`
 	Expire                        types.Int64  `tfsdk:"expire"`
 	DisplayTime                   types.Int64  `tfsdk:"display_time"`
@@ -115,6 +145,7 @@ type Notification struct {
 	Retry                         types.Int64  `tfsdk:"retry"`
 	UseEncryption                 types.Int64  `tfsdk:"use_encryption"`
 	ID                            types.Int64  `tfsdk:"id"`
+	SecretsRevision               types.Int64  `tfsdk:"secrets_revision"`
 	UpdateLibrary                 types.Bool   `tfsdk:"update_library"`
 	OnGrab                        types.Bool   `tfsdk:"on_grab"`
 	UseEuEndpoint                 types.Bool   `tfsdk:"use_eu_endpoint"`
@@ -122,6 +153,7 @@ type Notification struct {
 	UseSSL                        types.Bool   `tfsdk:"use_ssl"`
 	OnEpisodeFileDeleteForUpgrade types.Bool   `tfsdk:"on_episode_file_delete_for_upgrade"`
 	SendSilently                  types.Bool   `tfsdk:"send_silently"`
+	TimeSensitive                 types.Bool   `tfsdk:"time_sensitive"`
 	DirectMessage                 types.Bool   `tfsdk:"direct_message"`
 	CleanLibrary                  types.Bool   `tfsdk:"clean_library"`
 	AlwaysUpdate                  types.Bool   `tfsdk:"always_update"`
@@ -137,11 +169,14 @@ type Notification struct {
 	OnUpgrade                     types.Bool   `tfsdk:"on_upgrade"`
 	OnDownload                    types.Bool   `tfsdk:"on_download"`
 	OnImportComplete              types.Bool   `tfsdk:"on_import_complete"`
+	TestOnApply                   types.Bool   `tfsdk:"test_on_apply"`
 }
 
 func (n Notification) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
+			"test_on_apply":                      types.BoolType,
+			"extra_fields":                       types.StringType,
 			"tags":                               types.SetType{}.WithElementType(types.Int64Type),
 			"import_fields":                      types.SetType{}.WithElementType(types.Int64Type),
 			"grab_fields":                        types.SetType{}.WithElementType(types.Int64Type),
@@ -200,7 +235,9 @@ func (n Notification) getType() attr.Type {
 			"configuration_key":                  types.StringType,
 			"key":                                types.StringType,
 			"event":                              types.StringType,
+			"notification_name":                  types.StringType,
 			"notification_type":                  types.Int64Type,
+			"notification_type_name":             types.StringType,
 			"expire":                             types.Int64Type,
 			"display_time":                       types.Int64Type,
 			"priority":                           types.Int64Type,
@@ -216,6 +253,7 @@ func (n Notification) getType() attr.Type {
 			"use_ssl":                            types.BoolType,
 			"on_episode_file_delete_for_upgrade": types.BoolType,
 			"send_silently":                      types.BoolType,
+			"time_sensitive":                     types.BoolType,
 			"direct_message":                     types.BoolType,
 			"clean_library":                      types.BoolType,
 			"always_update":                      types.BoolType,
@@ -242,6 +280,14 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Notifications -->\nGeneric Notification resource. When possible use a specific resource instead.\nFor more information refer to [Notification](https://wiki.servarr.com/sonarr/settings#connect).",
 		Attributes: map[string]schema.Attribute{
+			"extra_fields": schema.StringAttribute{
+				MarkdownDescription: "Raw fields as JSON object (e.g. `jsonencode({ newField = true })`), to manage fields not supported by any other attribute. They are merged with the other fields, overriding them. When unset, it shows all the fields returned by Sonarr not supported by any other attribute.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					helpers.JSONObject(),
+				},
+			},
 			"on_grab": schema.BoolAttribute{
 				MarkdownDescription: "On grab flag.",
 				Optional:            true,
@@ -330,6 +376,8 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"secrets_revision": secretsRevisionAttribute,
+			"test_on_apply":    testOnApplyAttribute,
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Notification ID.",
 				Computed:            true,
@@ -363,6 +411,11 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				Optional:            true,
 				Computed:            true,
 			},
+			"time_sensitive": schema.BoolAttribute{
+				MarkdownDescription: "Time sensitive flag.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"update_library": schema.BoolAttribute{
 				MarkdownDescription: "Update library flag.",
 				Optional:            true,
@@ -387,6 +440,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Port.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 65535),
+				},
 			},
 			"method": schema.Int64Attribute{
 				MarkdownDescription: "Method. `1` POST, `2` PUT.",
@@ -421,15 +477,22 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
 				Optional:            true,
 				Computed:            true,
+				DeprecationMessage:  intEnumDeprecation("notification_type_name"),
 				Validators: []validator.Int64{
 					int64validator.OneOf(0, 1, 2, 3),
 				},
 			},
+			"notification_type_name": intEnumAttribute("Notification type.", "notification_type", notificationAppriseTypes),
 			"stateless_urls": schema.StringAttribute{
 				MarkdownDescription: "Stateless URLs.",
 				Optional:            true,
 				Computed:            true,
 			},
+			"notification_name": schema.StringAttribute{
+				MarkdownDescription: "Notification name.",
+				Optional:            true,
+				Computed:            true,
+			},
 			"configuration_key": schema.StringAttribute{
 				MarkdownDescription: "Configuration key.",
 				Optional:            true,
@@ -495,11 +558,17 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Server URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"click_url": schema.StringAttribute{
 				MarkdownDescription: "Click URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"auth_token": schema.StringAttribute{
 				MarkdownDescription: "Auth token.",
@@ -567,6 +636,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Host.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.Host(),
+				},
 			},
 			"icon": schema.StringAttribute{
 				MarkdownDescription: "Icon.",
@@ -639,6 +711,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "URL.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"user_key": schema.StringAttribute{
 				MarkdownDescription: "User key.",
@@ -654,6 +729,9 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 				MarkdownDescription: "Web hook url.",
 				Optional:            true,
 				Computed:            true,
+				Validators: []validator.String{
+					helpers.URL(),
+				},
 			},
 			"grab_fields": schema.SetAttribute{
 				MarkdownDescription: "Grab fields. `0` Overview, `1` Rating, `2` Genres, `3` Quality, `4` Group, `5` Size, `6` Links, `7` Release, `8` Poster, `9` Fanart.",
@@ -725,10 +803,32 @@ func (r *NotificationResource) Schema(_ context.Context, _ resource.SchemaReques
 	}
 }
 
+func (r *NotificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var implementation types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("implementation"), &implementation)...)
+
+	for _, event := range notificationUnsupportedEvents[implementation.ValueString()] {
+		var flag types.Bool
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(event), &flag)...)
+
+		if flag.ValueBool() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(event),
+				"Unsupported Notification Event",
+				fmt.Sprintf("Attribute %s cannot be enabled, as %s notifications do not support it.", event, implementation.ValueString()),
+			)
+		}
+	}
+}
+
 func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
+		r.testOnApply = providerData.TestOnApply
 	}
 }
 
@@ -745,7 +845,11 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
+	response, err := createNotification(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationResourceName, err))
 
@@ -757,6 +861,9 @@ func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRe
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Notification
 
+	state.ExtraFields = notification.ExtraFields
+	state.TestOnApply = notification.TestOnApply
+	state.SecretsRevision = notification.SecretsRevision
 	state.writeSensitive(notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -785,6 +892,9 @@ func (r *NotificationResource) Read(ctx context.Context, req resource.ReadReques
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Notification
 
+	state.ExtraFields = notification.ExtraFields
+	state.TestOnApply = notification.TestOnApply
+	state.SecretsRevision = notification.SecretsRevision
 	state.writeSensitive(notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -803,6 +913,10 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update Notification
 	request := notification.read(ctx, &resp.Diagnostics)
 
+	if testOnApply(notification.TestOnApply, r.testOnApply) && !testNotification(r.auth, r.client, request, notificationResourceName, notification, &resp.Diagnostics) {
+		return
+	}
+
 	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, request.GetId()).NotificationResource(*request).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationResourceName, err))
@@ -815,6 +929,9 @@ func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRe
 	// this is needed because of many empty fields are unknown in both plan and read
 	var state Notification
 
+	state.ExtraFields = notification.ExtraFields
+	state.TestOnApply = notification.TestOnApply
+	state.SecretsRevision = notification.SecretsRevision
 	state.writeSensitive(notification)
 	state.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
@@ -842,7 +959,7 @@ func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *NotificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, notificationResourceName, findNotificationIDs(r.auth, r.client, ""))
 	tflog.Trace(ctx, "imported "+notificationResourceName+": "+req.ID)
 }
 
@@ -882,6 +999,8 @@ func (n *Notification) write(ctx context.Context, notification *sonarr.Notificat
 	n.Topics = types.SetValueMust(types.StringType, nil)
 	n.FieldTags = types.SetValueMust(types.StringType, nil)
 	helpers.WriteFields(ctx, n, notification.GetFields(), notificationFields)
+	n.ExtraFields = helpers.WriteExtraFields(n.ExtraFields, notification.GetFields(), notificationFields)
+	n.NotificationTypeName = notificationAppriseTypes.name(n.NotificationType)
 }
 
 func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.NotificationResource {
@@ -905,7 +1024,11 @@ func (n *Notification) read(ctx context.Context, diags *diag.Diagnostics) *sonar
 	notification.SetImplementation(n.Implementation.ValueString())
 	notification.SetConfigContract(n.ConfigContract.ValueString())
 	diags.Append(n.Tags.ElementsAs(ctx, &notification.Tags, true)...)
-	notification.SetFields(helpers.ReadFields(ctx, n, notificationFields))
+
+	// the enum names are sent as their integer values
+	fields := *n
+	fields.NotificationType = notificationAppriseTypes.value(n.NotificationTypeName, n.NotificationType)
+	notification.SetFields(helpers.ReadExtraFields(n.ExtraFields, helpers.ReadFields(ctx, &fields, notificationFields)))
 
 	return notification
 }
@@ -956,3 +1079,15 @@ func (n *Notification) writeSensitive(notification *Notification) {
 		n.AuthPassword = notification.AuthPassword
 	}
 }
+
+// findNotificationIDs returns a function listing the IDs of the notifications with the given name.
+// Unless empty, implementation must match too, as for adoption.
+func findNotificationIDs(auth context.Context, client *sonarr.APIClient, implementation string) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.NotificationResource, error) {
+		response, _, err := client.NotificationAPI.ListNotification(auth).Execute()
+
+		return response, err
+	}, (*sonarr.NotificationResource).GetName, (*sonarr.NotificationResource).GetId, func(item *sonarr.NotificationResource) bool {
+		return implementation == "" || checkAdoptable(item.GetName(), implementation, item.GetImplementation(), "", "") == nil
+	})
+}
@@ -15,6 +15,11 @@ func TestAccNotificationResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Unsupported event
+			{
+				Config:      testAccNotificationResourceUnsupportedConfig("resourceTest"),
+				ExpectError: regexp.MustCompile("Unsupported Notification Event"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccNotificationResourceConfig("resourceTest", "false") + testUnauthorizedProvider,
@@ -74,3 +79,19 @@ func testAccNotificationResourceConfig(name, upgrade string) string {
 		tags = []
 	}`, upgrade, name)
 }
+
+func testAccNotificationResourceUnsupportedConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_notification" "test" {
+		on_grab     = true
+		on_download = true
+
+		name = "%s"
+
+		implementation  = "Trakt"
+		config_contract = "TraktSettings"
+
+		auth_user    = "User"
+		access_token = "Token"
+	}`, name)
+}
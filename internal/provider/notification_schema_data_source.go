@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const notificationSchemaDataSourceName = "notification_schema"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &NotificationSchemaDataSource{}
+
+func NewNotificationSchemaDataSource() datasource.DataSource {
+	return &NotificationSchemaDataSource{}
+}
+
+// NotificationSchemaDataSource defines the notification schema implementation.
+type NotificationSchemaDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+func (d *NotificationSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + notificationSchemaDataSourceName
+}
+
+func (d *NotificationSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = implementationSchemaDataSourceSchema("Notifications", "Notification", "notification")
+}
+
+func (d *NotificationSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *NotificationSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ImplementationSchemas
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Get notification schema current value
+	response, _, err := d.client.NotificationAPI.ListNotificationSchema(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationSchemaDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+notificationSchemaDataSourceName)
+	// Map response body to resource schema attribute
+	implementations := make([]implementationSchemaResource, len(response))
+	for i := range response {
+		implementations[i] = &response[i]
+	}
+
+	data.write(ctx, implementations, &Notification{}, notificationFields, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationSchemaDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccNotificationSchemaDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccNotificationSchemaDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_notification_schema.test", "schemas.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_notification_schema.test", "schemas.*", map[string]string{"implementation": "Webhook"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccNotificationSchemaDataSourceConfig = `
+data "sonarr_notification_schema" "test" {
+	implementation = "Webhook"
+}
+`
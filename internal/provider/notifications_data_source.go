@@ -51,6 +51,18 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 				Computed:            true,
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
+						"secrets_revision": schema.Int64Attribute{
+							MarkdownDescription: "Secrets revision. Always null, as it only applies to resources.",
+							Computed:            true,
+						},
+						"test_on_apply": schema.BoolAttribute{
+							MarkdownDescription: "Connectivity test flag. Always null, as it only applies to resources.",
+							Computed:            true,
+						},
+						"extra_fields": schema.StringAttribute{
+							MarkdownDescription: "Raw fields returned by Sonarr not supported by any other attribute, as JSON object.",
+							Computed:            true,
+						},
 						"on_grab": schema.BoolAttribute{
 							MarkdownDescription: "On grab flag.",
 							Computed:            true,
@@ -149,6 +161,10 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Add silently flag.",
 							Computed:            true,
 						},
+						"time_sensitive": schema.BoolAttribute{
+							MarkdownDescription: "Time sensitive flag.",
+							Computed:            true,
+						},
 						"update_library": schema.BoolAttribute{
 							MarkdownDescription: "Update library flag.",
 							Computed:            true,
@@ -189,10 +205,18 @@ func (d *NotificationsDataSource) Schema(_ context.Context, _ datasource.SchemaR
 							MarkdownDescription: "Notification type. `0` Info, `1` Success, `2` Warning, `3` Failure.",
 							Computed:            true,
 						},
+						"notification_type_name": schema.StringAttribute{
+							MarkdownDescription: "Notification type. Allowed values: " + notificationAppriseTypes.description() + ".",
+							Computed:            true,
+						},
 						"stateless_urls": schema.StringAttribute{
 							MarkdownDescription: "Stateless URLs.",
 							Computed:            true,
 						},
+						"notification_name": schema.StringAttribute{
+							MarkdownDescription: "Notification name.",
+							Computed:            true,
+						},
 						"configuration_key": schema.StringAttribute{
 							MarkdownDescription: "Configuration key.",
 							Computed:            true,
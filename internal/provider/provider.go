@@ -3,16 +3,22 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -32,9 +38,15 @@ type SonarrProvider struct {
 
 // Sonarr describes the provider data model.
 type Sonarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders          types.Set    `tfsdk:"extra_headers"`
+	DefaultTags           types.Set    `tfsdk:"default_tags"`
+	APIKey                types.String `tfsdk:"api_key"`
+	URL                   types.String `tfsdk:"url"`
+	MaxConcurrentRequests types.Int64  `tfsdk:"max_concurrent_requests"`
+	RequestsPerSecond     types.Int64  `tfsdk:"requests_per_second"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
+	ResponseCache         types.Bool   `tfsdk:"response_cache"`
+	TestOnApply           types.Bool   `tfsdk:"test_on_apply"`
 }
 
 // ExtraHeader is part of Sonarr.
@@ -45,8 +57,10 @@ type ExtraHeader struct {
 
 // SonarrData defines auth and client to be used when connecting to Sonarr.
 type SonarrData struct {
-	Auth   context.Context
-	Client *sonarr.APIClient
+	Auth          context.Context
+	Client        *sonarr.APIClient
+	AdoptExisting bool
+	TestOnApply   bool
 }
 
 func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -83,6 +97,45 @@ func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"default_tags": schema.SetAttribute{
+				MarkdownDescription: "Tag labels to be applied to every resource supporting tags. Missing tags are created on first write. Default tags are hidden from the `tags` attribute of resources and data sources, hence they never show up in diffs. Default tags must not be set explicitly in `tags`, otherwise writes fail.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^.*[^A-Z]+.*$`),
+							"String cannot contains uppercase values",
+						),
+					),
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Adopt existing objects on create. When enabled, download clients, indexers, notifications, quality profiles and tags with the same name of the one being created are updated in place and managed by Terraform, instead of failing or creating duplicates. Download clients, indexers and notifications are adopted only when implementation and protocol match too, and every adoption is reported as a warning.",
+				Optional:            true,
+			},
+			"test_on_apply": schema.BoolAttribute{
+				MarkdownDescription: "Default for the `test_on_apply` attribute of download client, indexer, notification, import list and metadata resources. When enabled, the Sonarr connectivity test runs before every create and update.",
+				Optional:            true,
+			},
+			"response_cache": schema.BoolAttribute{
+				MarkdownDescription: "Cache list responses in memory for the whole provider run, so that data sources sharing the same endpoint call it only once. Every write on an endpoint invalidates its cached responses.",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent requests sent to Sonarr. Write requests are always serialized, to avoid lock contention on Sonarr database. Defaults to no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests per second sent to Sonarr. Defaults to no limit.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -160,9 +213,27 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		"hostpath": parsedAPIURL.Host + parsedAPIURL.Path,
 	})
 
+	// Build transport
+	var transport http.RoundTripper = newRequestLimiter(http.DefaultTransport, data.MaxConcurrentRequests.ValueInt64(), data.RequestsPerSecond.ValueInt64())
+
+	// the cache sits below the default tags, so that the tags they create invalidate it
+	if data.ResponseCache.ValueBool() {
+		transport = newResponseCache(transport)
+	}
+
+	if len(data.DefaultTags.Elements()) > 0 {
+		labels := make([]string, len(data.DefaultTags.Elements()))
+		resp.Diagnostics.Append(data.DefaultTags.ElementsAs(ctx, &labels, false)...)
+		transport = newDefaultTagsTransport(transport, config, auth, labels)
+	}
+
+	config.HTTPClient = &http.Client{Transport: transport}
+
 	sonarrData := SonarrData{
-		Auth:   auth,
-		Client: sonarr.NewAPIClient(config),
+		Auth:          auth,
+		Client:        sonarr.NewAPIClient(config),
+		AdoptExisting: data.AdoptExisting.ValueBool(),
+		TestOnApply:   data.TestOnApply.ValueBool(),
 	}
 	resp.DataSourceData = &sonarrData
 	resp.ResourceData = &sonarrData
@@ -183,6 +254,7 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewDownloadClientDelugeResource,
 		NewDownloadClientTorrentDownloadStationResource,
 		NewDownloadClientFloodResource,
+		NewDownloadClientFreeboxResource,
 		NewDownloadClientHadoukenResource,
 		NewDownloadClientQbittorrentResource,
 		NewDownloadClientRtorrentResource,
@@ -209,7 +281,9 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 		// Import Lists
 		NewImportListExclusionResource,
 		NewImportListResource,
+		NewImportListAniListResource,
 		NewImportListCustomResource,
+		NewImportListMyAnimeListResource,
 		NewImportListSimklUserResource,
 		NewImportListSonarrResource,
 		NewImportListImdbResource,
@@ -226,7 +300,9 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Metadata
 		NewMetadataResource,
+		NewMetadataEmbyResource,
 		NewMetadataKodiResource,
+		NewMetadataPlexResource,
 		NewMetadataRoksboxResource,
 		NewMetadataWdtvResource,
 
@@ -242,10 +318,12 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 		NewNotificationJoinResource,
 		NewNotificationKodiResource,
 		NewNotificationMailgunResource,
+		NewNotificationNotifiarrResource,
 		NewNotificationNtfyResource,
 		NewNotificationPlexResource,
 		NewNotificationProwlResource,
 		NewNotificationPushbulletResource,
+		NewNotificationPushcutResource,
 		NewNotificationPushoverResource,
 		NewNotificationSendgridResource,
 		NewNotificationSignalResource,
@@ -281,6 +359,7 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewDownloadClientConfigDataSource,
 		NewDownloadClientDataSource,
 		NewDownloadClientsDataSource,
+		NewDownloadClientSchemaDataSource,
 		NewRemotePathMappingDataSource,
 		NewRemotePathMappingsDataSource,
 
@@ -288,12 +367,14 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewIndexerConfigDataSource,
 		NewIndexerDataSource,
 		NewIndexersDataSource,
+		NewIndexerSchemaDataSource,
 
 		// Import Lists
 		NewImportListExclusionDataSource,
 		NewImportListExclusionsDataSource,
 		NewImportListDataSource,
 		NewImportListsDataSource,
+		NewImportListSchemaDataSource,
 
 		// Media Management
 		NewMediaManagementDataSource,
@@ -304,13 +385,16 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		// Metadata
 		NewMetadataConsumersDataSource,
 		NewMetadataDataSource,
+		NewMetadataSchemaDataSource,
 
 		// Notifications
 		NewNotificationDataSource,
 		NewNotificationsDataSource,
+		NewNotificationSchemaDataSource,
 
 		// Profiles
 		NewCustomFormatDataSource,
+		NewCustomFormatJSONDataSource,
 		NewCustomFormatsDataSource,
 		NewDelayProfileDataSource,
 		NewDelayProfilesDataSource,
@@ -318,12 +402,15 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewQualityProfilesDataSource,
 		NewReleaseProfileDataSource,
 		NewReleaseProfilesDataSource,
+		NewReleaseScoreDataSource,
 		NewQualityDefinitionDataSource,
 		NewQualityDefinitionsDataSource,
 		NewCustomFormatConditionDataSource,
+		NewCustomFormatConditionIndexerFlagDataSource,
 		NewCustomFormatConditionLanguageDataSource,
 		NewCustomFormatConditionReleaseGroupDataSource,
 		NewCustomFormatConditionReleaseTitleDataSource,
+		NewCustomFormatConditionReleaseTypeDataSource,
 		NewCustomFormatConditionResolutionDataSource,
 		NewCustomFormatConditionSizeDataSource,
 		NewCustomFormatConditionSourceDataSource,
@@ -347,8 +434,13 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewAutoTagsDataSource,
 		NewAutoTagConditionDataSource,
 		NewAutoTagConditionGenresDataSource,
+		NewAutoTagConditionNetworkDataSource,
+		NewAutoTagConditionOriginalLanguageDataSource,
+		NewAutoTagConditionQualityProfileDataSource,
 		NewAutoTagConditionRootFolderDataSource,
 		NewAutoTagConditionSeriesTypeDataSource,
+		NewAutoTagConditionStatusDataSource,
+		NewAutoTagConditionYearDataSource,
 	}
 }
 
@@ -362,10 +454,20 @@ func New(version string) func() provider.Provider {
 }
 
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+func resourceConfigure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+	providerData := resourceProviderData(ctx, req, resp)
+	if providerData == nil {
+		return nil, nil
+	}
+
+	return providerData.Auth, providerData.Client
+}
+
+// resourceProviderData is a helper function to get the whole provider data for a specific resource.
+func resourceProviderData(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *SonarrData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return nil
 	}
 
 	providerData, ok := req.ProviderData.(*SonarrData)
@@ -375,10 +477,10 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 			fmt.Sprintf("Expected *SonarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData
 }
 
 func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -17,6 +18,24 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"sonarr": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain runs the acceptance tests against the in memory fake Sonarr
+// when explicitly requested via SONARR_FAKE and no real instance is configured via SONARR_URL.
+// The fake only covers the CRUD and parse behaviour: checks depending on Sonarr internals need a real instance.
+func TestMain(m *testing.M) {
+	if os.Getenv("TF_ACC") == "" || os.Getenv("SONARR_FAKE") == "" || os.Getenv("SONARR_URL") != "" {
+		os.Exit(m.Run())
+	}
+
+	server := httptest.NewServer(newFakeSonarr())
+	os.Setenv("SONARR_URL", server.URL)
+	os.Setenv("SONARR_API_KEY", fakeSonarrAPIKey)
+
+	code := m.Run()
+
+	server.Close()
+	os.Exit(code)
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
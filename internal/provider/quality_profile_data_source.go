@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
@@ -51,6 +52,20 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Quality ID to which cutoff.",
 				Computed:            true,
 			},
+			"cutoff_quality": schema.StringAttribute{
+				MarkdownDescription: "Name of the quality, or quality group, to which cutoff.",
+				Computed:            true,
+			},
+			"allowed_qualities": schema.ListAttribute{
+				MarkdownDescription: "Allowed quality names, from higher to lower.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"format_scores": schema.MapAttribute{
+				MarkdownDescription: "Custom format scores by name.",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
 			"cutoff_format_score": schema.Int64Attribute{
 				MarkdownDescription: "Cutoff format score.",
 				Computed:            true,
@@ -63,6 +78,10 @@ func (d *QualityProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 				MarkdownDescription: "Min upgrade format score.",
 				Computed:            true,
 			},
+			"export_json": schema.StringAttribute{
+				MarkdownDescription: "Portable Quality Profile JSON, referencing qualities and custom formats by name instead of ID. Allowed quality groups are ordered from higher to lower.",
+				Computed:            true,
+			},
 			"quality_groups": schema.ListNestedAttribute{
 				MarkdownDescription: "Quality groups.",
 				Computed:            true,
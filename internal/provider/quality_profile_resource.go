@@ -2,11 +2,17 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"slices"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -16,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -26,6 +34,7 @@ const qualityProfileResourceName = "quality_profile"
 var (
 	_ resource.Resource                = &QualityProfileResource{}
 	_ resource.ResourceWithImportState = &QualityProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &QualityProfileResource{}
 )
 
 func NewQualityProfileResource() resource.Resource {
@@ -34,15 +43,20 @@ func NewQualityProfileResource() resource.Resource {
 
 // QualityProfileResource defines the quality profile implementation.
 type QualityProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
 }
 
 // QualityProfile describes the quality profile data model.
 type QualityProfile struct {
 	FormatItems           types.Set    `tfsdk:"format_items"`
 	QualityGroups         types.List   `tfsdk:"quality_groups"`
+	AllowedQualities      types.List   `tfsdk:"allowed_qualities"`
+	FormatScores          types.Map    `tfsdk:"format_scores"`
+	CutoffQuality         types.String `tfsdk:"cutoff_quality"`
 	Name                  types.String `tfsdk:"name"`
+	ExportJSON            types.String `tfsdk:"export_json"`
 	ID                    types.Int64  `tfsdk:"id"`
 	Cutoff                types.Int64  `tfsdk:"cutoff"`
 	MinFormatScore        types.Int64  `tfsdk:"min_format_score"`
@@ -56,7 +70,11 @@ func (p QualityProfile) getType() attr.Type {
 		map[string]attr.Type{
 			"quality_groups":           types.ListType{}.WithElementType(QualityGroup{}.getType()),
 			"format_items":             types.SetType{}.WithElementType(FormatItem{}.getType()),
+			"allowed_qualities":        types.ListType{}.WithElementType(types.StringType),
+			"format_scores":            types.MapType{}.WithElementType(types.Int64Type),
+			"cutoff_quality":           types.StringType,
 			"name":                     types.StringType,
+			"export_json":              types.StringType,
 			"id":                       types.Int64Type,
 			"cutoff":                   types.Int64Type,
 			"min_format_score":         types.Int64Type,
@@ -104,7 +122,7 @@ func (r *QualityProfileResource) Metadata(_ context.Context, req resource.Metada
 
 func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/sonarr/settings#quality-profiles) documentation.",
+		MarkdownDescription: "<!-- subcategory:Profiles -->\nQuality Profile resource.\nFor more information refer to [Quality Profile](https://wiki.servarr.com/sonarr/settings#quality-profiles) documentation.\nQuality profiles have no language: preferred languages are scored through custom formats with a [language condition](../data-sources/custom_format_condition_language) and `format_scores`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				MarkdownDescription: "Quality Profile ID.",
@@ -127,6 +145,14 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Optional:            true,
 				Computed:            true,
 			},
+			"cutoff_quality": schema.StringAttribute{
+				MarkdownDescription: "Name of the allowed quality, or quality group, to which cutoff. Alternative to `cutoff`.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("cutoff")),
+				},
+			},
 			"cutoff_format_score": schema.Int64Attribute{
 				MarkdownDescription: "Cutoff format score.",
 				Optional:            true,
@@ -143,9 +169,37 @@ func (r *QualityProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				Default:             int64default.StaticInt64(1),
 			},
+			"export_json": schema.StringAttribute{
+				MarkdownDescription: "Portable Quality Profile JSON, referencing qualities and custom formats by name instead of ID. Allowed quality groups are ordered from higher to lower.",
+				Computed:            true,
+			},
+			"allowed_qualities": schema.ListAttribute{
+				MarkdownDescription: "Ordered list of allowed quality names, from higher to lower. Each one is a single quality group. Alternative to `quality_groups`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"format_scores": schema.MapAttribute{
+				MarkdownDescription: "Custom format scores by name. Scores must be different from 0. Alternative to `format_items`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Map{
+					mapvalidator.ConflictsWith(path.MatchRoot("format_items")),
+					mapvalidator.ValueInt64sAre(int64validator.NoneOf(0)),
+				},
+			},
 			"quality_groups": schema.ListNestedAttribute{
 				MarkdownDescription: "Ordered list of allowed quality groups.",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.List{
+					listvalidator.ExactlyOneOf(path.MatchRoot("allowed_qualities")),
+				},
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: r.getQualityGroupSchema().Attributes,
 				},
@@ -249,9 +303,10 @@ func (r QualityProfileResource) getFormatItemsSchema() schema.Schema {
 }
 
 func (r *QualityProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
 	}
 }
 
@@ -265,11 +320,18 @@ func (r *QualityProfileResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	// Resolve names not yet known during plan
+	r.resolveNames(ctx, profile, req.Config, nil, false, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Create resource
 	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
 
 	// Create new QualityProfile
-	response, _, err := r.client.QualityProfileAPI.CreateQualityProfile(r.auth).QualityProfileResource(*request).Execute()
+	response, err := createQualityProfile(r.auth, r.client, request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityProfileResourceName, err))
 
@@ -316,6 +378,13 @@ func (r *QualityProfileResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	// Resolve names not yet known during plan
+	r.resolveNames(ctx, profile, req.Config, nil, false, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build Update resource
 	request := profile.read(ctx, r.getQualityIDs(&resp.Diagnostics), r.getFormatsIDs(&resp.Diagnostics), &resp.Diagnostics)
 
@@ -354,8 +423,36 @@ func (r *QualityProfileResource) Delete(ctx context.Context, req resource.Delete
 	resp.State.RemoveResource(ctx)
 }
 
+func (r *QualityProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to resolve on destroy or with the provider not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var profile, state *QualityProfile
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &profile)...)
+
+	// State is null on creation
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.resolveNames(ctx, profile, req.Config, state, true, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &profile)...)
+}
+
 func (r *QualityProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, qualityProfileResourceName, findQualityProfileIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+qualityProfileResourceName+": "+req.ID)
 }
 
@@ -396,6 +493,39 @@ func (p *QualityProfile) write(ctx context.Context, profile *sonarr.QualityProfi
 	diags.Append(tempDiag...)
 	p.FormatItems, tempDiag = types.SetValueFrom(ctx, FormatItem{}.getType(), formatItems)
 	diags.Append(tempDiag...)
+
+	p.CutoffQuality = types.StringNull()
+	allowedQualities := make([]string, 0, len(profile.GetItems()))
+	formatScores := make(map[string]int64, len(formatItems))
+
+	for _, g := range profile.GetItems() {
+		id, name, qualities := qualityProfileItemNames(&g)
+		if id == profile.GetCutoff() {
+			p.CutoffQuality = types.StringValue(name)
+		}
+
+		if g.GetAllowed() {
+			allowedQualities = append(allowedQualities, qualities...)
+		}
+	}
+
+	for _, f := range formatItems {
+		formatScores[f.Name.ValueString()] = f.Score.ValueInt64()
+	}
+
+	// Order qualities from higher to lower
+	slices.Reverse(allowedQualities)
+	p.AllowedQualities, tempDiag = types.ListValueFrom(ctx, types.StringType, allowedQualities)
+	diags.Append(tempDiag...)
+	p.FormatScores, tempDiag = types.MapValueFrom(ctx, types.Int64Type, formatScores)
+	diags.Append(tempDiag...)
+
+	exportJSON, err := exportQualityProfileJSON(profile)
+	if err != nil {
+		diags.AddError(helpers.ResourceError, "Unable to export "+qualityProfileResourceName+", got error: "+err.Error())
+	}
+
+	p.ExportJSON = types.StringValue(exportJSON)
 }
 
 func (g *QualityGroup) write(ctx context.Context, group *sonarr.QualityProfileQualityItemResource, diags *diag.Diagnostics) {
@@ -561,6 +691,275 @@ func (f *FormatItem) read() *sonarr.ProfileFormatItemResource {
 	return formatItem
 }
 
+// qualityProfileExport is the portable quality profile, referencing qualities and custom formats by name.
+type qualityProfileExport struct {
+	FormatItems           map[string]int32           `json:"formatItems"`
+	Name                  string                     `json:"name"`
+	Cutoff                string                     `json:"cutoff"`
+	Items                 []qualityProfileExportItem `json:"items"`
+	MinFormatScore        int32                      `json:"minFormatScore"`
+	MinUpgradeFormatScore int32                      `json:"minUpgradeFormatScore"`
+	CutoffFormatScore     int32                      `json:"cutoffFormatScore"`
+	UpgradeAllowed        bool                       `json:"upgradeAllowed"`
+}
+
+// qualityProfileExportItem is an allowed quality group, or single quality, of the portable quality profile.
+type qualityProfileExportItem struct {
+	Name      string   `json:"name"`
+	Qualities []string `json:"qualities"`
+}
+
+// exportQualityProfileJSON encodes the quality profile without IDs, so that it can be shared across instances.
+func exportQualityProfileJSON(profile *sonarr.QualityProfileResource) (string, error) {
+	export := qualityProfileExport{
+		FormatItems:           make(map[string]int32),
+		Name:                  profile.GetName(),
+		Items:                 []qualityProfileExportItem{},
+		MinFormatScore:        profile.GetMinFormatScore(),
+		MinUpgradeFormatScore: profile.GetMinUpgradeFormatScore(),
+		CutoffFormatScore:     profile.GetCutoffFormatScore(),
+		UpgradeAllowed:        profile.GetUpgradeAllowed(),
+	}
+
+	for _, item := range profile.GetItems() {
+		id, name, qualities := qualityProfileItemNames(&item)
+
+		// the cutoff is either a group or a single quality ID
+		if id == profile.GetCutoff() {
+			export.Cutoff = name
+		}
+
+		if item.GetAllowed() {
+			export.Items = append(export.Items, qualityProfileExportItem{Name: name, Qualities: qualities})
+		}
+	}
+
+	// Order groups from higher to lower
+	slices.Reverse(export.Items)
+
+	for _, f := range profile.GetFormatItems() {
+		if f.GetScore() != 0 {
+			export.FormatItems[f.GetName()] = f.GetScore()
+		}
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+
+	return string(data), err
+}
+
+// qualityProfileItemNames returns ID and name of a quality group, or single quality, along with its quality names.
+func qualityProfileItemNames(item *sonarr.QualityProfileQualityItemResource) (int32, string, []string) {
+	if len(item.GetItems()) == 0 {
+		return item.Quality.GetId(), item.Quality.GetName(), []string{item.Quality.GetName()}
+	}
+
+	qualities := make([]string, len(item.GetItems()))
+	for i, q := range item.GetItems() {
+		qualities[i] = q.Quality.GetName()
+	}
+
+	return item.GetId(), item.GetName(), qualities
+}
+
+// resolveNames computes quality groups, cutoff and format items from the names set in config.
+// During plan, custom formats not found yet are left unknown, since they could be created by the same apply.
+// Names unchanged since the prior state, if any, are not looked up again.
+func (r QualityProfileResource) resolveNames(ctx context.Context, profile *QualityProfile, cfg tfsdk.Config, prior *QualityProfile, planning bool, diags *diag.Diagnostics) {
+	var (
+		config    *QualityProfile
+		qualities []sonarr.QualityDefinitionResource
+		formats   []sonarr.CustomFormatResource
+		err       error
+	)
+
+	diags.Append(cfg.Get(ctx, &config)...)
+
+	if diags.HasError() {
+		return
+	}
+
+	if prior != nil {
+		profile.reuseResolved(config, prior)
+	}
+
+	if !config.AllowedQualities.IsNull() && !config.AllowedQualities.IsUnknown() {
+		qualities, _, err = r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionsDataSourceName, err))
+
+			return
+		}
+	}
+
+	if !config.FormatScores.IsNull() && !config.FormatScores.IsUnknown() {
+		formats, _, err = r.client.CustomFormatAPI.ListCustomFormat(r.auth).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatsDataSourceName, err))
+
+			return
+		}
+	}
+
+	profile.resolve(ctx, config, qualities, formats, planning, diags)
+}
+
+// reuseResolved copies the prior resolution of the shortcut attributes unchanged since the prior state,
+// clearing them from config so that they are not resolved again.
+func (p *QualityProfile) reuseResolved(config, prior *QualityProfile) {
+	if !config.AllowedQualities.IsNull() && config.AllowedQualities.Equal(prior.AllowedQualities) {
+		p.QualityGroups = prior.QualityGroups
+		config.AllowedQualities = types.ListNull(types.StringType)
+	}
+
+	// the cutoff is resolved against the quality groups, which must be unchanged too
+	if !config.CutoffQuality.IsNull() && config.CutoffQuality.Equal(prior.CutoffQuality) && p.QualityGroups.Equal(prior.QualityGroups) {
+		p.Cutoff = prior.Cutoff
+		config.CutoffQuality = types.StringNull()
+	}
+
+	if !config.FormatScores.IsNull() && config.FormatScores.Equal(prior.FormatScores) {
+		p.FormatItems = prior.FormatItems
+		config.FormatScores = types.MapNull(types.Int64Type)
+	}
+}
+
+// resolve fills the IDs referenced by name in the shortcut attributes of config.
+func (p *QualityProfile) resolve(ctx context.Context, config *QualityProfile, qualities []sonarr.QualityDefinitionResource, formats []sonarr.CustomFormatResource, planning bool, diags *diag.Diagnostics) {
+	if !config.AllowedQualities.IsNull() {
+		p.resolveQualityGroups(ctx, config.AllowedQualities, qualities, diags)
+	}
+
+	if !config.CutoffQuality.IsNull() {
+		p.resolveCutoff(ctx, config.CutoffQuality, diags)
+	}
+
+	if !config.FormatScores.IsNull() {
+		p.resolveFormatItems(ctx, config.FormatScores, formats, planning, diags)
+	}
+}
+
+func (p *QualityProfile) resolveQualityGroups(ctx context.Context, names types.List, qualities []sonarr.QualityDefinitionResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	if names.IsUnknown() {
+		p.QualityGroups = types.ListUnknown(QualityGroup{}.getType())
+
+		return
+	}
+
+	values := make([]types.String, len(names.Elements()))
+	diags.Append(names.ElementsAs(ctx, &values, true)...)
+
+	groups := make([]QualityGroup, 0, len(values))
+
+	for _, name := range values {
+		if name.IsUnknown() {
+			p.QualityGroups = types.ListUnknown(QualityGroup{}.getType())
+
+			return
+		}
+
+		index := slices.IndexFunc(qualities, func(q sonarr.QualityDefinitionResource) bool {
+			return q.Quality.GetName() == name.ValueString()
+		})
+		if index < 0 {
+			diags.AddAttributeError(path.Root("allowed_qualities"), helpers.ResourceError, fmt.Sprintf("Unable to find quality %q", name.ValueString()))
+
+			return
+		}
+
+		quality := qualities[index].Quality
+		group := QualityGroup{
+			Name: types.StringNull(),
+			ID:   types.Int64Null(),
+		}
+		group.Qualities, tempDiag = types.ListValueFrom(ctx, Quality{}.getType(), []Quality{{
+			ID:         types.Int64Value(int64(quality.GetId())),
+			Name:       types.StringValue(quality.GetName()),
+			Source:     types.StringValue(string(quality.GetSource())),
+			Resolution: types.Int64Value(int64(quality.GetResolution())),
+		}})
+		diags.Append(tempDiag...)
+
+		groups = append(groups, group)
+	}
+
+	p.QualityGroups, tempDiag = types.ListValueFrom(ctx, QualityGroup{}.getType(), groups)
+	diags.Append(tempDiag...)
+}
+
+func (p *QualityProfile) resolveCutoff(ctx context.Context, name types.String, diags *diag.Diagnostics) {
+	if name.IsUnknown() || p.QualityGroups.IsUnknown() {
+		p.Cutoff = types.Int64Unknown()
+
+		return
+	}
+
+	groups := make([]QualityGroup, len(p.QualityGroups.Elements()))
+	diags.Append(p.QualityGroups.ElementsAs(ctx, &groups, true)...)
+
+	for _, g := range groups {
+		qualities := make([]Quality, len(g.Qualities.Elements()))
+		diags.Append(g.Qualities.ElementsAs(ctx, &qualities, true)...)
+
+		id, groupName := g.ID, g.Name
+		if len(qualities) == 1 {
+			id, groupName = qualities[0].ID, qualities[0].Name
+		}
+
+		if groupName.ValueString() == name.ValueString() {
+			p.Cutoff = id
+
+			return
+		}
+	}
+
+	diags.AddAttributeError(path.Root("cutoff_quality"), helpers.ResourceError, fmt.Sprintf("Cutoff %q must be one of the allowed qualities", name.ValueString()))
+}
+
+func (p *QualityProfile) resolveFormatItems(ctx context.Context, scores types.Map, formats []sonarr.CustomFormatResource, planning bool, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	if scores.IsUnknown() {
+		p.FormatItems = types.SetUnknown(FormatItem{}.getType())
+
+		return
+	}
+
+	values := make(map[string]types.Int64, len(scores.Elements()))
+	diags.Append(scores.ElementsAs(ctx, &values, true)...)
+
+	items := make([]FormatItem, 0, len(values))
+
+	for name, score := range values {
+		index := slices.IndexFunc(formats, func(f sonarr.CustomFormatResource) bool {
+			return f.GetName() == name
+		})
+
+		if score.IsUnknown() || (index < 0 && planning) {
+			p.FormatItems = types.SetUnknown(FormatItem{}.getType())
+
+			return
+		}
+
+		if index < 0 {
+			diags.AddAttributeError(path.Root("format_scores"), helpers.ResourceError, fmt.Sprintf("Unable to find custom format %q", name))
+
+			return
+		}
+
+		items = append(items, FormatItem{
+			Name:   types.StringValue(name),
+			Format: types.Int64Value(int64(formats[index].GetId())),
+			Score:  score,
+		})
+	}
+
+	p.FormatItems, tempDiag = types.SetValueFrom(ctx, FormatItem{}.getType(), items)
+	diags.Append(tempDiag...)
+}
+
 func (r QualityProfileResource) getQualityIDs(diags *diag.Diagnostics) []int32 {
 	// Get qualitydefinitions current value
 	qualities, _, err := r.client.QualityDefinitionAPI.ListQualityDefinition(r.auth).Execute()
@@ -599,3 +998,12 @@ func (r QualityProfileResource) getFormatsIDs(diags *diag.Diagnostics) []int32 {
 
 	return formatIDs
 }
+
+// findQualityProfileIDs returns a function listing the IDs of the quality profiles with the given name.
+func findQualityProfileIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.QualityProfileResource, error) {
+		response, _, err := client.QualityProfileAPI.ListQualityProfile(auth).Execute()
+
+		return response, err
+	}, (*sonarr.QualityProfileResource).GetName, (*sonarr.QualityProfileResource).GetId, nil)
+}
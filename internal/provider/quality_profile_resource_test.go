@@ -1,11 +1,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccQualityProfileResource(t *testing.T) {
@@ -25,6 +32,7 @@ func TestAccQualityProfileResource(t *testing.T) {
 				Config: testAccQualityProfileResourceConfig("example-4k"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "name", "example-4k"),
+					resource.TestMatchResourceAttr("sonarr_quality_profile.test", "export_json", regexp.MustCompile(`"cutoff": "WEB 2160p"`)),
 					resource.TestCheckResourceAttrSet("sonarr_quality_profile.test", "id"),
 				),
 			},
@@ -40,6 +48,15 @@ func TestAccQualityProfileResource(t *testing.T) {
 					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "name", "example-HD"),
 				),
 			},
+			// Update with names testing
+			{
+				Config: testAccQualityProfileResourceNamesConfig("example-HD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "cutoff_quality", "WEBDL-2160p"),
+					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "quality_groups.#", "2"),
+					resource.TestCheckResourceAttr("sonarr_quality_profile.test", "format_items.0.score", "-10"),
+				),
+			},
 			// ImportState testing
 			{
 				ResourceName:      "sonarr_quality_profile.test",
@@ -118,3 +135,257 @@ func testAccQualityProfileResourceConfig(name string) string {
 	}
 	`, name)
 }
+
+func testAccQualityProfileResourceNamesConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_custom_format" "test" {
+		include_custom_format_when_renaming = false
+		name = "QualityFormatTest"
+
+		specifications = [
+			{
+				name = "Arabic"
+				implementation = "LanguageSpecification"
+				negate = false
+				required = false
+				value = "31"
+			}
+		]
+	}
+
+	resource "sonarr_quality_profile" "test" {
+		name              = "%s"
+		upgrade_allowed   = true
+		allowed_qualities = ["Bluray-2160p", "WEBDL-2160p"]
+		cutoff_quality    = "WEBDL-2160p"
+
+		format_scores = {
+			(sonarr_custom_format.test.name) = -10
+		}
+	}
+	`, name)
+}
+
+func TestExportQualityProfileJSON(t *testing.T) {
+	t.Parallel()
+
+	quality := func(id int32, name string, allowed bool) sonarr.QualityProfileQualityItemResource {
+		item := sonarr.NewQualityProfileQualityItemResource()
+		item.SetAllowed(allowed)
+		item.SetItems([]sonarr.QualityProfileQualityItemResource{})
+		item.SetQuality(sonarr.Quality{Id: &id, Name: *sonarr.NewNullableString(&name)})
+
+		return *item
+	}
+
+	group := sonarr.NewQualityProfileQualityItemResource()
+	group.SetId(1000)
+	group.SetName("WEB 1080p")
+	group.SetAllowed(true)
+	group.SetItems([]sonarr.QualityProfileQualityItemResource{quality(3, "WEBDL-1080p", true), quality(15, "WEBRip-1080p", true)})
+
+	format := sonarr.NewProfileFormatItemResource()
+	format.SetName("x265 (HD)")
+	format.SetFormat(1)
+	format.SetScore(-10000)
+
+	ignored := sonarr.NewProfileFormatItemResource()
+	ignored.SetName("Ignored")
+	ignored.SetFormat(2)
+	ignored.SetScore(0)
+
+	profile := sonarr.NewQualityProfileResource()
+	profile.SetId(5)
+	profile.SetName("HD")
+	profile.SetUpgradeAllowed(true)
+	profile.SetCutoff(1000)
+	profile.SetMinUpgradeFormatScore(1)
+	profile.SetItems([]sonarr.QualityProfileQualityItemResource{quality(1, "SDTV", false), *group, quality(7, "Bluray-1080p", true)})
+	profile.SetFormatItems([]sonarr.ProfileFormatItemResource{*format, *ignored})
+
+	data, err := exportQualityProfileJSON(profile)
+	assert.NoError(t, err)
+
+	var export qualityProfileExport
+
+	assert.NoError(t, json.Unmarshal([]byte(data), &export))
+	assert.Equal(t, qualityProfileExport{
+		FormatItems: map[string]int32{"x265 (HD)": -10000},
+		Name:        "HD",
+		Cutoff:      "WEB 1080p",
+		Items: []qualityProfileExportItem{
+			{Name: "Bluray-1080p", Qualities: []string{"Bluray-1080p"}},
+			{Name: "WEB 1080p", Qualities: []string{"WEBDL-1080p", "WEBRip-1080p"}},
+		},
+		MinUpgradeFormatScore: 1,
+		UpgradeAllowed:        true,
+	}, export)
+	assert.NotContains(t, data, `"id"`)
+}
+
+func TestQualityProfileResolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	definition := func(id int32, name string) sonarr.QualityDefinitionResource {
+		quality := sonarr.NewQuality()
+		quality.SetId(id)
+		quality.SetName(name)
+		quality.SetSource(sonarr.QUALITYSOURCE_WEB)
+		quality.SetResolution(1080)
+
+		item := sonarr.NewQualityDefinitionResource()
+		item.SetQuality(*quality)
+
+		return *item
+	}
+
+	customFormat := sonarr.NewCustomFormatResource()
+	customFormat.SetId(4)
+	customFormat.SetName("x265 (HD)")
+
+	qualities := []sonarr.QualityDefinitionResource{definition(3, "WEBDL-1080p"), definition(7, "Bluray-1080p")}
+	formats := []sonarr.CustomFormatResource{*customFormat}
+
+	config := func(scores map[string]attr.Value) *QualityProfile {
+		return &QualityProfile{
+			AllowedQualities: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("Bluray-1080p"), types.StringValue("WEBDL-1080p")}),
+			CutoffQuality:    types.StringValue("WEBDL-1080p"),
+			FormatScores:     types.MapValueMust(types.Int64Type, scores),
+		}
+	}
+
+	t.Run("resolved", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			profile QualityProfile
+			diags   diag.Diagnostics
+		)
+
+		profile.resolve(ctx, config(map[string]attr.Value{"x265 (HD)": types.Int64Value(-10000)}), qualities, formats, false, &diags)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, int64(3), profile.Cutoff.ValueInt64())
+
+		groups := make([]QualityGroup, 0)
+		assert.False(t, profile.QualityGroups.ElementsAs(ctx, &groups, false).HasError())
+		assert.Len(t, groups, 2)
+
+		quality := make([]Quality, 0)
+		assert.False(t, groups[0].Qualities.ElementsAs(ctx, &quality, false).HasError())
+		assert.Equal(t, []Quality{{
+			ID:         types.Int64Value(7),
+			Name:       types.StringValue("Bluray-1080p"),
+			Source:     types.StringValue("web"),
+			Resolution: types.Int64Value(1080),
+		}}, quality)
+
+		items := make([]FormatItem, 0)
+		assert.False(t, profile.FormatItems.ElementsAs(ctx, &items, false).HasError())
+		assert.Equal(t, []FormatItem{{Name: types.StringValue("x265 (HD)"), Format: types.Int64Value(4), Score: types.Int64Value(-10000)}}, items)
+	})
+
+	t.Run("format created later", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			profile QualityProfile
+			diags   diag.Diagnostics
+		)
+
+		profile.resolve(ctx, config(map[string]attr.Value{"New": types.Int64Value(10)}), qualities, formats, true, &diags)
+		assert.False(t, diags.HasError())
+		assert.True(t, profile.FormatItems.IsUnknown())
+
+		profile.resolve(ctx, config(map[string]attr.Value{"New": types.Int64Value(10)}), qualities, formats, false, &diags)
+		assert.True(t, diags.HasError())
+	})
+
+	t.Run("cutoff not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			profile QualityProfile
+			diags   diag.Diagnostics
+		)
+
+		cfg := config(map[string]attr.Value{})
+		cfg.CutoffQuality = types.StringValue("SDTV")
+
+		profile.resolve(ctx, cfg, qualities, formats, true, &diags)
+		assert.True(t, diags.HasError())
+	})
+	t.Run("unknown config", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			profile QualityProfile
+			diags   diag.Diagnostics
+		)
+
+		cfg := config(map[string]attr.Value{})
+		cfg.AllowedQualities = types.ListUnknown(types.StringType)
+		cfg.FormatScores = types.MapUnknown(types.Int64Type)
+
+		profile.resolve(ctx, cfg, nil, nil, true, &diags)
+		assert.False(t, diags.HasError())
+		assert.True(t, profile.QualityGroups.IsUnknown())
+		assert.True(t, profile.Cutoff.IsUnknown())
+		assert.True(t, profile.FormatItems.IsUnknown())
+	})
+	t.Run("unchanged", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			prior, profile QualityProfile
+			diags          diag.Diagnostics
+		)
+
+		cfg := config(map[string]attr.Value{"x265 (HD)": types.Int64Value(-10000)})
+		prior.resolve(ctx, cfg, qualities, formats, false, &diags)
+		prior.AllowedQualities, prior.CutoffQuality, prior.FormatScores = cfg.AllowedQualities, cfg.CutoffQuality, cfg.FormatScores
+
+		// nothing is looked up again, hence no quality definitions nor custom formats are needed
+		cfg = config(map[string]attr.Value{"x265 (HD)": types.Int64Value(-10000)})
+		cfg.CutoffQuality = types.StringValue("Bluray-1080p")
+		profile.reuseResolved(cfg, &prior)
+		profile.resolve(ctx, cfg, nil, nil, true, &diags)
+		assert.False(t, diags.HasError())
+		assert.Equal(t, prior.QualityGroups, profile.QualityGroups)
+		assert.Equal(t, prior.FormatItems, profile.FormatItems)
+		assert.Equal(t, int64(7), profile.Cutoff.ValueInt64())
+	})
+}
+
+func TestQualityProfileWriteCutoff(t *testing.T) {
+	t.Parallel()
+
+	quality := sonarr.NewQuality()
+	quality.SetId(3)
+	quality.SetName("WEBDL-1080p")
+
+	item := sonarr.NewQualityProfileQualityItemResource()
+	item.SetQuality(*quality)
+	item.SetAllowed(true)
+
+	response := sonarr.NewQualityProfileResource()
+	response.SetItems([]sonarr.QualityProfileQualityItemResource{*item})
+	response.SetFormatItems([]sonarr.ProfileFormatItemResource{})
+
+	var (
+		profile QualityProfile
+		diags   diag.Diagnostics
+	)
+
+	response.SetCutoff(3)
+	profile.write(context.Background(), response, &diags)
+	assert.False(t, diags.HasError())
+	assert.Equal(t, types.StringValue("WEBDL-1080p"), profile.CutoffQuality)
+
+	// a cutoff out of the items is not an empty name
+	response.SetCutoff(99)
+	profile.write(context.Background(), response, &diags)
+	assert.False(t, diags.HasError())
+	assert.True(t, profile.CutoffQuality.IsNull())
+}
@@ -67,6 +67,20 @@ func (d *QualityProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 							MarkdownDescription: "Quality ID to which cutoff.",
 							Computed:            true,
 						},
+						"cutoff_quality": schema.StringAttribute{
+							MarkdownDescription: "Name of the quality, or quality group, to which cutoff.",
+							Computed:            true,
+						},
+						"allowed_qualities": schema.ListAttribute{
+							MarkdownDescription: "Allowed quality names, from higher to lower.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"format_scores": schema.MapAttribute{
+							MarkdownDescription: "Custom format scores by name.",
+							Computed:            true,
+							ElementType:         types.Int64Type,
+						},
 						"cutoff_format_score": schema.Int64Attribute{
 							MarkdownDescription: "Cutoff format score.",
 							Computed:            true,
@@ -79,6 +93,10 @@ func (d *QualityProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 							MarkdownDescription: "Min upgrade format score.",
 							Computed:            true,
 						},
+						"export_json": schema.StringAttribute{
+							MarkdownDescription: "Portable Quality Profile JSON, referencing qualities and custom formats by name instead of ID. Allowed quality groups are ordered from higher to lower.",
+							Computed:            true,
+						},
 						"quality_groups": schema.ListNestedAttribute{
 							MarkdownDescription: "Quality groups.",
 							Computed:            true,
@@ -6,6 +6,8 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -23,8 +26,9 @@ const releaseProfileResourceName = "release_profile"
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &ReleaseProfileResource{}
-	_ resource.ResourceWithImportState = &ReleaseProfileResource{}
+	_ resource.Resource                     = &ReleaseProfileResource{}
+	_ resource.ResourceWithImportState      = &ReleaseProfileResource{}
+	_ resource.ResourceWithConfigValidators = &ReleaseProfileResource{}
 )
 
 func NewReleaseProfileResource() resource.Resource {
@@ -98,6 +102,9 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.StringType,
 				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(helpers.TermRegex()),
+				},
 			},
 			"ignored": schema.SetAttribute{
 				MarkdownDescription: "Ignored terms. At least one of `required` and `ignored` must be set.",
@@ -105,6 +112,9 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 				Computed:            true,
 				ElementType:         types.StringType,
 				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(helpers.TermRegex()),
+				},
 			},
 			"tags": schema.SetAttribute{
 				MarkdownDescription: "List of associated tags.",
@@ -116,6 +126,15 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 	}
 }
 
+func (r *ReleaseProfileResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.AtLeastOneOf(
+			path.MatchRoot("required"),
+			path.MatchRoot("ignored"),
+		),
+	}
+}
+
 func (r *ReleaseProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
 		r.client = client
@@ -223,7 +242,7 @@ func (r *ReleaseProfileResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ReleaseProfileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, releaseProfileResourceName, findReleaseProfileIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+releaseProfileResourceName+": "+req.ID)
 }
 
@@ -254,3 +273,12 @@ func (p *ReleaseProfile) read(ctx context.Context, diags *diag.Diagnostics) *son
 
 	return profile
 }
+
+// findReleaseProfileIDs returns a function listing the IDs of the release profiles with the given name.
+func findReleaseProfileIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.ReleaseProfileResource, error) {
+		response, _, err := client.ReleaseProfileAPI.ListReleaseProfile(auth).Execute()
+
+		return response, err
+	}, (*sonarr.ReleaseProfileResource).GetName, (*sonarr.ReleaseProfileResource).GetId, nil)
+}
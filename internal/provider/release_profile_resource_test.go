@@ -15,6 +15,11 @@ func TestAccReleaseProfileResource(t *testing.T) {
 		PreCheck:                 func() { testAccPreCheck(t) },
 		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
+			// Invalid regex
+			{
+				Config:      testAccReleaseProfileResourceConfig("resourceTest", "/test(/"),
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
 			// Unauthorized Create
 			{
 				Config:      testAccReleaseProfileResourceConfig("resourceTest", "test1") + testUnauthorizedProvider,
@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/mitchellh/hashstructure/v2"
+)
+
+const (
+	releaseScoreDataSourceName = "release_score"
+	// releaseScoreGigabyte is the unit of the size conditions.
+	releaseScoreGigabyte = 1 << 30
+	// releaseScoreOriginalLanguage is the language condition value matching the series original language.
+	releaseScoreOriginalLanguage = -2
+)
+
+var errReleaseScoreCondition = errors.New("condition cannot be evaluated along with size and indexer flags")
+
+// releaseScoreLocalConditions are the conditions Sonarr cannot evaluate from the title only.
+var releaseScoreLocalConditions = []string{customFormatConditionSizeImplementation, customFormatConditionIndexerFlagImplementation}
+
+// releaseScoreSources are the IDs used by the source conditions.
+var releaseScoreSources = map[sonarr.QualitySource]int64{
+	sonarr.QUALITYSOURCE_UNKNOWN:        0,
+	sonarr.QUALITYSOURCE_TELEVISION:     1,
+	sonarr.QUALITYSOURCE_TELEVISION_RAW: 2,
+	sonarr.QUALITYSOURCE_WEB:            3,
+	sonarr.QUALITYSOURCE_WEB_RIP:        4,
+	sonarr.QUALITYSOURCE_DVD:            5,
+	sonarr.QUALITYSOURCE_BLURAY:         6,
+	sonarr.QUALITYSOURCE_BLURAY_RAW:     7,
+}
+
+// releaseScoreReleaseTypes are the IDs used by the release type conditions.
+var releaseScoreReleaseTypes = map[sonarr.ReleaseType]int64{
+	sonarr.RELEASETYPE_UNKNOWN:        0,
+	sonarr.RELEASETYPE_SINGLE_EPISODE: 1,
+	sonarr.RELEASETYPE_MULTI_EPISODE:  2,
+	sonarr.RELEASETYPE_SEASON_PACK:    3,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ReleaseScoreDataSource{}
+
+func NewReleaseScoreDataSource() datasource.DataSource {
+	return &ReleaseScoreDataSource{}
+}
+
+// ReleaseScoreDataSource defines the release score implementation.
+type ReleaseScoreDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// ReleaseScore describes the release score data model.
+type ReleaseScore struct {
+	CustomFormats    types.Set    `tfsdk:"custom_formats"`
+	Languages        types.Set    `tfsdk:"languages"`
+	IndexerFlags     types.Set    `tfsdk:"indexer_flags"`
+	Title            types.String `tfsdk:"title"`
+	Quality          types.String `tfsdk:"quality"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	Size             types.Int64  `tfsdk:"size"`
+	QualityID        types.Int64  `tfsdk:"quality_id"`
+	Score            types.Int64  `tfsdk:"score"`
+	ID               types.Int64  `tfsdk:"id"`
+}
+
+func (d *ReleaseScoreDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + releaseScoreDataSourceName
+}
+
+func (d *ReleaseScoreDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Profiles -->\nRelease Score data source, parsing a release title and scoring its custom formats against a [Quality Profile](../resources/quality_profile).\nSince Sonarr parses the title only, size and indexer flags conditions are evaluated by the provider against `size` and `indexer_flags`.",
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Release title.",
+				Required:            true,
+			},
+			"quality_profile_id": schema.Int64Attribute{
+				MarkdownDescription: "Quality Profile ID used for scoring.",
+				Required:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "Release size in bytes.",
+				Optional:            true,
+			},
+			"indexer_flags": schema.SetAttribute{
+				MarkdownDescription: "Release indexer flags. Allowed values: " + customFormatConditionIndexerFlags.description() + ".",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(customFormatConditionIndexerFlags.names()...)),
+				},
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Parsed quality name.",
+				Computed:            true,
+			},
+			"quality_id": schema.Int64Attribute{
+				MarkdownDescription: "Parsed quality ID.",
+				Computed:            true,
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Parsed language names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"custom_formats": schema.SetAttribute{
+				MarkdownDescription: "Matched custom format names.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"score": schema.Int64Attribute{
+				MarkdownDescription: "Total custom format score under the quality profile.",
+				Computed:            true,
+			},
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Release Score ID.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ReleaseScoreDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *ReleaseScoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *ReleaseScore
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get quality profile current value
+	profile, _, err := d.client.QualityProfileAPI.GetQualityProfileById(d.auth, int32(data.QualityProfileID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityProfileDataSourceName, err))
+
+		return
+	}
+
+	// Parse release title
+	release, _, err := d.client.ParseAPI.GetParse(d.auth).Title(data.Title.ValueString()).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseScoreDataSourceName, err))
+
+		return
+	}
+
+	if release.ParsedEpisodeInfo == nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(releaseScoreDataSourceName, "title", data.Title.ValueString()))
+
+		return
+	}
+
+	// Get custom formats current value, to evaluate the conditions unknown to Sonarr
+	formats, _, err := d.client.CustomFormatAPI.ListCustomFormat(d.auth).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatsDataSourceName, err))
+
+		return
+	}
+
+	flags := data.indexerFlags(ctx, &resp.Diagnostics)
+
+	matched, err := releaseCustomFormats(release, formats, data.Size.ValueInt64(), flags)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Read, releaseScoreDataSourceName, err))
+
+		return
+	}
+
+	hash, err := hashstructure.Hash(fmt.Sprintf("%d:%s:%d:%d", data.QualityProfileID.ValueInt64(), data.Title.ValueString(), data.Size.ValueInt64(), flags), hashstructure.FormatV2, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.DataSourceError, helpers.ParseClientError(helpers.Read, releaseScoreDataSourceName, err))
+
+		return
+	}
+
+	data.write(ctx, release, matched, profile, &resp.Diagnostics)
+	// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+	data.ID = types.Int64Value(int64(hash))
+
+	tflog.Trace(ctx, "read "+releaseScoreDataSourceName)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (s *ReleaseScore) write(ctx context.Context, release *sonarr.ParseResource, matched []sonarr.CustomFormatResource, profile *sonarr.QualityProfileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	qualityModel := release.ParsedEpisodeInfo.GetQuality()
+	quality := qualityModel.GetQuality()
+
+	s.Quality = types.StringValue(quality.GetName())
+	s.QualityID = types.Int64Value(int64(quality.GetId()))
+	s.Score = types.Int64Value(releaseScore(matched, profile))
+
+	languages := make([]string, len(release.GetLanguages()))
+	for i, l := range release.GetLanguages() {
+		languages[i] = l.GetName()
+	}
+
+	formats := make([]string, len(matched))
+	for i, f := range matched {
+		formats[i] = f.GetName()
+	}
+
+	s.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languages)
+	diags.Append(tempDiag...)
+	s.CustomFormats, tempDiag = types.SetValueFrom(ctx, types.StringType, formats)
+	diags.Append(tempDiag...)
+}
+
+// releaseScore sums the quality profile scores of the matched custom formats, as Sonarr does when grabbing.
+func releaseScore(formats []sonarr.CustomFormatResource, profile *sonarr.QualityProfileResource) int64 {
+	var score int64
+
+	for _, f := range formats {
+		for _, item := range profile.GetFormatItems() {
+			if item.GetFormat() == f.GetId() {
+				score += int64(item.GetScore())
+			}
+		}
+	}
+
+	return score
+}
+
+// indexerFlags returns the indexer flags bitmask.
+func (s *ReleaseScore) indexerFlags(ctx context.Context, diags *diag.Diagnostics) int64 {
+	var (
+		names []string
+		flags int64
+	)
+
+	diags.Append(s.IndexerFlags.ElementsAs(ctx, &names, true)...)
+
+	for _, name := range names {
+		flags |= customFormatConditionIndexerFlags.value(types.StringValue(name), types.Int64Null()).ValueInt64()
+	}
+
+	return flags
+}
+
+// releaseCustomFormats returns the custom formats matching the release.
+// Sonarr parses the title with neither size nor indexer flags, so the formats with such conditions are evaluated again:
+// their other conditions are evaluated locally only when the Sonarr match cannot tell.
+func releaseCustomFormats(release *sonarr.ParseResource, formats []sonarr.CustomFormatResource, size, flags int64) ([]sonarr.CustomFormatResource, error) {
+	matched := make([]sonarr.CustomFormatResource, 0, len(release.GetCustomFormats()))
+
+	for _, format := range formats {
+		sonarrMatch := slices.ContainsFunc(release.GetCustomFormats(), func(f sonarr.CustomFormatResource) bool {
+			return f.GetId() == format.GetId()
+		})
+
+		local, others := make(map[string][]sonarr.CustomFormatSpecificationSchema), make(map[string][]sonarr.CustomFormatSpecificationSchema)
+
+		for _, spec := range format.GetSpecifications() {
+			if slices.Contains(releaseScoreLocalConditions, spec.GetImplementation()) {
+				local[spec.GetImplementation()] = append(local[spec.GetImplementation()], spec)
+			} else {
+				others[spec.GetImplementation()] = append(others[spec.GetImplementation()], spec)
+			}
+		}
+
+		match := sonarrMatch
+
+		if len(local) > 0 {
+			var err error
+
+			match, err = releaseLocalMatch(release, local, others, sonarrMatch, size, flags)
+			if err != nil {
+				return nil, fmt.Errorf("custom format %q: %w", format.GetName(), err)
+			}
+		}
+
+		if match {
+			matched = append(matched, format)
+		}
+	}
+
+	return matched, nil
+}
+
+// releaseLocalMatch matches a custom format having size or indexer flags conditions.
+// Sonarr matched it with size and indexer flags unset, which tells the result of the other conditions
+// whenever size and indexer flags conditions were satisfied by unset values too.
+func releaseLocalMatch(release *sonarr.ParseResource, local, others map[string][]sonarr.CustomFormatSpecificationSchema, sonarrMatch bool, size, flags int64) (bool, error) {
+	if match, _ := releaseConditionsMatch(release, local, size, flags); !match {
+		return false, nil
+	}
+
+	if match, _ := releaseConditionsMatch(release, local, 0, 0); match {
+		return sonarrMatch, nil
+	}
+
+	return releaseConditionsMatch(release, others, size, flags)
+}
+
+// releaseConditionsMatch matches like Sonarr does: every required condition and at least one condition per implementation.
+func releaseConditionsMatch(release *sonarr.ParseResource, specs map[string][]sonarr.CustomFormatSpecificationSchema, size, flags int64) (bool, error) {
+	for _, group := range specs {
+		groupMatch := false
+
+		for _, spec := range group {
+			match, err := releaseConditionMatch(release, spec, size, flags)
+			if err != nil {
+				return false, err
+			}
+
+			if spec.GetRequired() && !match {
+				return false, nil
+			}
+
+			groupMatch = groupMatch || match
+		}
+
+		if !groupMatch {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// releaseConditionMatch evaluates a single condition, the same way Sonarr does.
+func releaseConditionMatch(release *sonarr.ParseResource, spec sonarr.CustomFormatSpecificationSchema, size, flags int64) (bool, error) {
+	var (
+		match bool
+		err   error
+	)
+
+	info := release.GetParsedEpisodeInfo()
+	qualityModel := info.GetQuality()
+	quality := qualityModel.GetQuality()
+
+	switch spec.GetImplementation() {
+	case customFormatConditionSizeImplementation:
+		match = float64(size) > releaseConditionNumber(spec, "min")*releaseScoreGigabyte && float64(size) <= releaseConditionNumber(spec, "max")*releaseScoreGigabyte
+	case customFormatConditionIndexerFlagImplementation:
+		flag := int64(releaseConditionNumber(spec, "value"))
+		match = flags&flag == flag
+	case customFormatConditionReleaseTitleImplementation:
+		match, err = releaseConditionRegex(spec, info.GetReleaseTitle())
+	case customFormatConditionReleaseGroupImplementation:
+		match, err = releaseConditionRegex(spec, info.GetReleaseGroup())
+	case customFormatConditionSourceImplementation:
+		match = releaseScoreSources[quality.GetSource()] == int64(releaseConditionNumber(spec, "value"))
+	case customFormatConditionResolutionImplementation:
+		match = int64(quality.GetResolution()) == int64(releaseConditionNumber(spec, "value"))
+	case customFormatConditionReleaseTypeImplementation:
+		match = releaseScoreReleaseTypes[info.GetReleaseType()] == int64(releaseConditionNumber(spec, "value"))
+	case customFormatConditionLanguageImplementation:
+		match = releaseConditionLanguage(release, spec)
+	default:
+		return false, fmt.Errorf("%w: %s", errReleaseScoreCondition, spec.GetImplementation())
+	}
+
+	return match != spec.GetNegate(), err
+}
+
+// releaseConditionLanguage matches the release languages, resolving the original language from the series.
+func releaseConditionLanguage(release *sonarr.ParseResource, spec sonarr.CustomFormatSpecificationSchema) bool {
+	language := int32(releaseConditionNumber(spec, "value"))
+
+	series := release.GetSeries()
+	if original := series.GetOriginalLanguage(); language == releaseScoreOriginalLanguage && original.GetId() != 0 {
+		language = original.GetId()
+	}
+
+	except := false
+
+	for _, f := range spec.GetFields() {
+		if f.GetName() == "exceptLanguage" {
+			except, _ = f.GetValue().(bool)
+		}
+	}
+
+	return slices.ContainsFunc(release.GetLanguages(), func(l sonarr.Language) bool {
+		return (l.GetId() == language) != except
+	})
+}
+
+// releaseConditionRegex matches the value case insensitively, as Sonarr does.
+func releaseConditionRegex(spec sonarr.CustomFormatSpecificationSchema, value string) (bool, error) {
+	pattern, _ := importListField(spec.GetFields(), "value")
+
+	expression, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return false, fmt.Errorf("%w: %s", errReleaseScoreCondition, err)
+	}
+
+	return expression.MatchString(value), nil
+}
+
+// releaseConditionNumber returns the numeric value of the named field.
+func releaseConditionNumber(spec sonarr.CustomFormatSpecificationSchema, name string) float64 {
+	for _, f := range spec.GetFields() {
+		if f.GetName() != name {
+			continue
+		}
+
+		switch value := f.GetValue().(type) {
+		case float64:
+			return value
+		case string:
+			number, _ := strconv.ParseFloat(value, 64)
+
+			return number
+		}
+	}
+
+	return 0
+}
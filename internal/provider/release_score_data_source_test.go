@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccReleaseScoreDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccReleaseScoreDataSourceConfig + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccReleaseScoreDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sonarr_release_score.test", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_release_score.test", "quality", "WEBDL-2160p"),
+					resource.TestCheckResourceAttr("data.sonarr_release_score.test", "score", "100"),
+					resource.TestCheckTypeSetElemAttr("data.sonarr_release_score.test", "custom_formats.*", "ReleaseScoreTest"),
+				),
+			},
+		},
+	})
+}
+
+const testAccReleaseScoreDataSourceConfig = `
+resource "sonarr_custom_format" "test" {
+	include_custom_format_when_renaming = false
+	name = "ReleaseScoreTest"
+
+	specifications = [
+		{
+			name = "x265"
+			implementation = "ReleaseTitleSpecification"
+			negate = false
+			required = true
+			value = "x265"
+		}
+	]
+}
+
+resource "sonarr_custom_format" "size" {
+	include_custom_format_when_renaming = false
+	name = "ReleaseScoreSize"
+
+	specifications = [
+		{
+			name = "x265"
+			implementation = "ReleaseTitleSpecification"
+			negate = false
+			required = true
+			value = "x265"
+		},
+		{
+			name = "Large"
+			implementation = "SizeSpecification"
+			negate = false
+			required = true
+			min = 4
+			max = 100
+		}
+	]
+}
+
+resource "sonarr_quality_profile" "test" {
+	name              = "ReleaseScoreTest"
+	allowed_qualities = ["WEBDL-2160p"]
+
+	format_scores = {
+		(sonarr_custom_format.test.name) = 100
+		(sonarr_custom_format.size.name) = 25
+	}
+}
+
+data "sonarr_release_score" "test" {
+	title              = "Show.S01.2160p.WEB-DL.DV.x265-GRP"
+	quality_profile_id = sonarr_quality_profile.test.id
+}
+
+data "sonarr_release_score" "sized" {
+	title              = "Show.S01.2160p.WEB-DL.DV.x265-GRP"
+	size               = 10737418240
+	indexer_flags      = ["freeleech"]
+	quality_profile_id = sonarr_quality_profile.test.id
+}
+`
+
+func TestReleaseScore(t *testing.T) {
+	t.Parallel()
+
+	format := func(id int32, score int32) sonarr.ProfileFormatItemResource {
+		item := sonarr.NewProfileFormatItemResource()
+		item.SetFormat(id)
+		item.SetScore(score)
+
+		return *item
+	}
+
+	customFormat := func(id int32) sonarr.CustomFormatResource {
+		item := sonarr.NewCustomFormatResource()
+		item.SetId(id)
+
+		return *item
+	}
+
+	profile := sonarr.NewQualityProfileResource()
+	profile.SetFormatItems([]sonarr.ProfileFormatItemResource{format(1, 100), format(2, -10000), format(3, 25)})
+
+	assert.Equal(t, int64(125), releaseScore([]sonarr.CustomFormatResource{customFormat(1), customFormat(3)}, profile))
+	assert.Equal(t, int64(-9900), releaseScore([]sonarr.CustomFormatResource{customFormat(1), customFormat(2)}, profile))
+	assert.Equal(t, int64(0), releaseScore([]sonarr.CustomFormatResource{customFormat(4)}, profile))
+	assert.Equal(t, int64(0), releaseScore(nil, profile))
+}
+
+func TestReleaseCustomFormats(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, value interface{}) sonarr.Field {
+		item := sonarr.NewField()
+		item.SetName(name)
+		item.SetValue(value)
+
+		return *item
+	}
+
+	spec := func(implementation string, required, negate bool, fields ...sonarr.Field) sonarr.CustomFormatSpecificationSchema {
+		item := sonarr.NewCustomFormatSpecificationSchema()
+		item.SetImplementation(implementation)
+		item.SetRequired(required)
+		item.SetNegate(negate)
+		item.SetFields(fields)
+
+		return *item
+	}
+
+	customFormat := func(id int32, specs ...sonarr.CustomFormatSpecificationSchema) sonarr.CustomFormatResource {
+		item := sonarr.NewCustomFormatResource()
+		item.SetId(id)
+		item.SetSpecifications(specs)
+
+		return *item
+	}
+
+	x265 := spec(customFormatConditionReleaseTitleImplementation, true, false, field("value", "x26[45]"))
+	large := spec(customFormatConditionSizeImplementation, true, false, field("min", float64(4)), field("max", float64(100)))
+	small := spec(customFormatConditionSizeImplementation, true, true, field("min", float64(4)), field("max", float64(100)))
+	freeleech := spec(customFormatConditionIndexerFlagImplementation, false, false, field("value", float64(1)))
+	scene := spec(customFormatConditionIndexerFlagImplementation, false, false, field("value", float64(16)))
+	lookbehind := spec(customFormatConditionReleaseTitleImplementation, true, false, field("value", "(?<!HD)x265"))
+
+	formats := []sonarr.CustomFormatResource{
+		customFormat(1, x265),
+		customFormat(2, x265, large),
+		customFormat(3, x265, small),
+		customFormat(4, freeleech, scene),
+	}
+
+	info := sonarr.NewParsedEpisodeInfo()
+	info.SetReleaseTitle("Show.S01.2160p.WEB-DL.DV.x265-GRP")
+
+	// Sonarr matched with neither size nor indexer flags
+	release := sonarr.NewParseResource()
+	release.SetParsedEpisodeInfo(*info)
+	release.SetCustomFormats([]sonarr.CustomFormatResource{formats[0], formats[2]})
+
+	ids := func(formats []sonarr.CustomFormatResource) []int32 {
+		result := make([]int32, len(formats))
+		for i, f := range formats {
+			result[i] = f.GetId()
+		}
+
+		return result
+	}
+
+	tests := map[string]struct {
+		expected []int32
+		size     int64
+		flags    int64
+	}{
+		"unset": {
+			expected: []int32{1, 3},
+		},
+		"large": {
+			size:     10 << 30,
+			expected: []int32{1, 2},
+		},
+		"flags": {
+			flags:    1 | 8,
+			expected: []int32{1, 3, 4},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			matched, err := releaseCustomFormats(release, formats, test.size, test.flags)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, ids(matched))
+		})
+	}
+
+	// conditions Go cannot evaluate are reported only when needed
+	_, err := releaseCustomFormats(release, []sonarr.CustomFormatResource{customFormat(5, lookbehind, large)}, 0, 0)
+	assert.NoError(t, err)
+	_, err = releaseCustomFormats(release, []sonarr.CustomFormatResource{customFormat(5, lookbehind, large)}, 10<<30, 0)
+	assert.ErrorIs(t, err, errReleaseScoreCondition)
+}
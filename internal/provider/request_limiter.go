@@ -0,0 +1,124 @@
+package provider
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// requestLimiter limits concurrency and rate of the requests sent to Sonarr.
+// Writes are always serialized, while reads run in parallel up to the concurrency limit.
+type requestLimiter struct {
+	last     time.Time
+	next     http.RoundTripper
+	slots    chan struct{}
+	interval time.Duration
+	writes   sync.Mutex
+	mu       sync.Mutex
+}
+
+// newRequestLimiter wraps the given transport with the request limiter.
+// Zero values for concurrency and rate mean no limit.
+func newRequestLimiter(next http.RoundTripper, maxConcurrentRequests, requestsPerSecond int64) *requestLimiter {
+	limiter := &requestLimiter{
+		next: next,
+	}
+
+	if maxConcurrentRequests > 0 {
+		limiter.slots = make(chan struct{}, maxConcurrentRequests)
+	}
+
+	if requestsPerSecond > 0 {
+		limiter.interval = time.Second / time.Duration(requestsPerSecond)
+	}
+
+	return limiter
+}
+
+// RoundTrip holds the write lock and the concurrency slot until the response body is closed,
+// so that reading a slow body still counts against the limits.
+func (l *requestLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	var releases []func()
+
+	release := func() {
+		for _, r := range releases {
+			r()
+		}
+	}
+
+	if req.Method != http.MethodGet {
+		l.writes.Lock()
+		releases = append(releases, l.writes.Unlock)
+	}
+
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+			releases = append(releases, func() { <-l.slots })
+		case <-req.Context().Done():
+			release()
+
+			return nil, req.Context().Err()
+		}
+	}
+
+	if err := l.wait(req.Context()); err != nil {
+		release()
+
+		return nil, err
+	}
+
+	resp, err := l.next.RoundTrip(req)
+	if err != nil {
+		release()
+
+		return nil, err
+	}
+
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: release}
+
+	return resp, nil
+}
+
+// releasingBody releases the request limits once the response body is closed.
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
+
+// wait blocks until the next request can be sent according to the rate limit.
+func (l *requestLimiter) wait(ctx context.Context) error {
+	if l.interval == 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+
+	next := l.last.Add(l.interval)
+	if now := time.Now(); next.Before(now) {
+		next = now
+	}
+
+	l.last = next
+	l.mu.Unlock()
+
+	timer := time.NewTimer(time.Until(next))
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
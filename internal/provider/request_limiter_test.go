@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method        string
+		maxConcurrent int64
+		expected      int32
+		slowBody      bool
+	}{
+		"parallel_reads": {
+			method:   http.MethodGet,
+			expected: 4,
+		},
+		"limited_reads": {
+			method:        http.MethodGet,
+			maxConcurrent: 2,
+			expected:      2,
+		},
+		"serialized_writes": {
+			method:   http.MethodPut,
+			expected: 1,
+		},
+		// the limits hold until the body is read, not just until the headers are received
+		"limited_slow_bodies": {
+			method:        http.MethodGet,
+			maxConcurrent: 2,
+			expected:      2,
+			slowBody:      true,
+		},
+		"serialized_slow_bodies": {
+			method:   http.MethodPut,
+			expected: 1,
+			slowBody: true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var current, peak atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				value := current.Add(1)
+				defer current.Add(-1)
+
+				for {
+					old := peak.Load()
+					if value <= old || peak.CompareAndSwap(old, value) {
+						break
+					}
+				}
+
+				if test.slowBody {
+					w.WriteHeader(http.StatusOK)
+					w.(http.Flusher).Flush()
+				}
+
+				time.Sleep(50 * time.Millisecond)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: newRequestLimiter(http.DefaultTransport, test.maxConcurrent, 0)}
+
+			var wg sync.WaitGroup
+
+			for range 4 {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					req, _ := http.NewRequest(test.method, server.URL, nil)
+					resp, err := client.Do(req)
+					assert.NoError(t, err)
+					_, _ = io.ReadAll(resp.Body)
+					resp.Body.Close()
+				}()
+			}
+
+			wg.Wait()
+			assert.Equal(t, test.expected, peak.Load())
+		})
+	}
+}
+
+func TestRequestLimiterRate(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newRequestLimiter(http.DefaultTransport, 0, 20)}
+	start := time.Now()
+
+	for range 5 {
+		resp, err := client.Get(server.URL)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// first request is immediate, the others are spaced by 50ms.
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
@@ -0,0 +1,176 @@
+package provider
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const apiPathPrefix = "/api/v3/"
+
+// cachedEndpoints are the list endpoints read by the singular data sources.
+var cachedEndpoints = []string{
+	"autotagging",
+	"customformat",
+	"delayprofile",
+	"downloadclient",
+	"importlist",
+	"importlistexclusion",
+	"indexer",
+	"language",
+	"metadata",
+	"notification",
+	"qualitydefinition",
+	"qualityprofile",
+	"releaseprofile",
+	"remotepathmapping",
+	"rootfolder",
+	"series",
+	"tag",
+}
+
+// cacheDependencies are the endpoints changed by Sonarr as a side effect of a write on another endpoint.
+var cacheDependencies = map[string][]string{
+	// custom formats are listed into the quality profile format items
+	"customformat": {"qualityprofile"},
+}
+
+// responseCache caches list responses for the whole provider run.
+// Every write on an endpoint invalidates all the cached responses of the same endpoint and of its dependencies.
+type responseCache struct {
+	next    http.RoundTripper
+	entries map[string]*responseCacheEntry
+	mu      sync.Mutex
+}
+
+// responseCacheEntry is a single cached response.
+type responseCacheEntry struct {
+	header   http.Header
+	endpoint string
+	body     []byte
+	status   int
+	mu       sync.Mutex
+}
+
+// newResponseCache wraps the given transport with the response cache.
+func newResponseCache(next http.RoundTripper) *responseCache {
+	return &responseCache{
+		next:    next,
+		entries: make(map[string]*responseCacheEntry),
+	}
+}
+
+func (c *responseCache) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet {
+		// invalidate both before and after the write, to drop responses read in the meantime.
+		c.invalidate(req.URL.Path)
+		defer c.invalidate(req.URL.Path)
+
+		return c.next.RoundTrip(req)
+	}
+
+	if !isCachedPath(req.URL.Path) {
+		return c.next.RoundTrip(req)
+	}
+
+	key := req.URL.String()
+
+	c.mu.Lock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &responseCacheEntry{endpoint: endpointName(req.URL.Path)}
+		c.entries[key] = entry
+	}
+
+	c.mu.Unlock()
+
+	// concurrent requests for the same key wait for the first one to populate the entry.
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.body != nil {
+		return entry.response(req), nil
+	}
+
+	resp, err := c.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if err != nil {
+		return nil, err
+	}
+
+	entry.header = resp.Header.Clone()
+	entry.status = resp.StatusCode
+	entry.body = body
+
+	return entry.response(req), nil
+}
+
+// invalidate removes all the cached responses of the endpoint the path belongs to, and of its dependencies.
+func (c *responseCache) invalidate(path string) {
+	endpoint := endpointName(path)
+	scope := append([]string{endpoint}, cacheDependencies[endpoint]...)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if slices.Contains(scope, entry.endpoint) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// response builds a new response from the cached entry.
+func (e *responseCacheEntry) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(e.status) + " " + http.StatusText(e.status),
+		StatusCode:    e.status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// endpointPath returns the endpoint root path (e.g. `/api/v3/downloadclient` for `/api/v3/downloadclient/1`).
+func endpointPath(path string) string {
+	index := strings.Index(path, apiPathPrefix)
+	if index < 0 {
+		return path
+	}
+
+	endpoint, _, _ := strings.Cut(path[index+len(apiPathPrefix):], "/")
+
+	return path[:index+len(apiPathPrefix)] + endpoint
+}
+
+// endpointName returns the endpoint name (e.g. `downloadclient` for `/api/v3/downloadclient/1`).
+func endpointName(path string) string {
+	index := strings.Index(path, apiPathPrefix)
+	if index < 0 {
+		return ""
+	}
+
+	endpoint, _, _ := strings.Cut(path[index+len(apiPathPrefix):], "/")
+
+	return endpoint
+}
+
+// isCachedPath checks if the path is one of the cached list endpoints.
+func isCachedPath(path string) bool {
+	return endpointPath(path) == path && slices.Contains(cachedEndpoints, endpointName(path))
+}
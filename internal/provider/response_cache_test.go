@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEndpointPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path     string
+		expected string
+	}{
+		"list": {
+			path:     "/api/v3/downloadclient",
+			expected: "/api/v3/downloadclient",
+		},
+		"single": {
+			path:     "/api/v3/downloadclient/1",
+			expected: "/api/v3/downloadclient",
+		},
+		"base_path": {
+			path:     "/sonarr/api/v3/config/naming",
+			expected: "/sonarr/api/v3/config",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, endpointPath(test.path))
+		})
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			calls.Add(1)
+			_, _ = w.Write([]byte(`[{"id":1,"label":"test"}]`))
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":2,"label":"other"}`))
+	}))
+	defer server.Close()
+
+	client, auth := testFakeClient(server.URL)
+	client.GetConfig().HTTPClient = &http.Client{Transport: newResponseCache(http.DefaultTransport)}
+
+	for range 3 {
+		tags, _, err := client.TagAPI.ListTag(auth).Execute()
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+	}
+
+	assert.Equal(t, int32(1), calls.Load())
+
+	// writes invalidate the cache
+	_, _, err := client.TagAPI.CreateTag(auth).TagResource(*sonarr.NewTagResource()).Execute()
+	assert.NoError(t, err)
+
+	_, _, err = client.TagAPI.ListTag(auth).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), calls.Load())
+}
+
+func TestIsCachedPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		path     string
+		expected bool
+	}{
+		"list":       {path: "/api/v3/tag", expected: true},
+		"base_path":  {path: "/sonarr/api/v3/indexer", expected: true},
+		"single":     {path: "/api/v3/tag/1", expected: false},
+		"schema":     {path: "/api/v3/downloadclient/schema", expected: false},
+		"status":     {path: "/api/v3/system/status", expected: false},
+		"config":     {path: "/api/v3/config/naming", expected: false},
+		"parse":      {path: "/api/v3/parse", expected: false},
+		"not_listed": {path: "/api/v3/indexerflag", expected: false},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, isCachedPath(test.path))
+		})
+	}
+}
+
+func TestResponseCacheInvalidation(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+
+	calls := make(map[string]int)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if r.Method == http.MethodGet {
+			mu.Lock()
+			calls[r.URL.Path]++
+			mu.Unlock()
+		}
+
+		_, _ = w.Write([]byte(`{"id":1}`))
+	}))
+	defer server.Close()
+
+	transport := newResponseCache(http.DefaultTransport)
+	get := func(path string) {
+		req := httptest.NewRequest(http.MethodGet, server.URL+path, nil)
+		req.RequestURI = ""
+		resp, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+	write := func(path string) {
+		req := httptest.NewRequest(http.MethodDelete, server.URL+path, nil)
+		req.RequestURI = ""
+		resp, err := transport.RoundTrip(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	for _, path := range []string{"/api/v3/importlist", "/api/v3/importlistexclusion", "/api/v3/qualityprofile"} {
+		get(path)
+	}
+
+	// a write only invalidates the same endpoint, not the ones sharing its prefix
+	write("/api/v3/importlist/1")
+	// a custom format write changes the quality profile format items
+	write("/api/v3/customformat/1")
+
+	for _, path := range []string{"/api/v3/importlist", "/api/v3/importlistexclusion", "/api/v3/qualityprofile"} {
+		get(path)
+	}
+
+	assert.Equal(t, map[string]int{
+		"/api/v3/importlist":          2,
+		"/api/v3/importlistexclusion": 1,
+		"/api/v3/qualityprofile":      2,
+	}, calls)
+}
+
+func TestResponseCacheDefaultTags(t *testing.T) {
+	t.Parallel()
+
+	var tagLists atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/api/v3/tag" && r.Method == http.MethodGet:
+			tagLists.Add(1)
+			_, _ = w.Write([]byte(`[{"id":1,"label":"other"}]`))
+		case r.URL.Path == "/api/v3/tag" && r.Method == http.MethodPost:
+			_, _ = w.Write([]byte(`{"id":7,"label":"terraform-managed"}`))
+		default:
+			_, _ = w.Write([]byte(`{"id":2,"tags":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	client, auth := testFakeClient(server.URL)
+	client.GetConfig().HTTPClient = &http.Client{
+		Transport: newDefaultTagsTransport(newResponseCache(http.DefaultTransport), client.GetConfig(), auth, []string{"terraform-managed"}),
+	}
+
+	_, _, err := client.TagAPI.ListTag(auth).Execute()
+	assert.NoError(t, err)
+
+	// the default tag is created on write, through the cache
+	_, _, err = client.DelayProfileAPI.CreateDelayProfile(auth).DelayProfileResource(*sonarr.NewDelayProfileResource()).Execute()
+	assert.NoError(t, err)
+
+	_, _, err = client.TagAPI.ListTag(auth).Execute()
+	assert.NoError(t, err)
+	assert.Equal(t, int32(2), tagLists.Load())
+}
@@ -0,0 +1,11 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// secretsRevisionAttribute is the resource attribute triggering a new push of the secrets.
+var secretsRevisionAttribute = schema.Int64Attribute{
+	MarkdownDescription: "Secrets revision, a manual trigger to push secrets again. Sonarr masks secrets in its responses, so a secret changed outside Terraform cannot be detected, only a cleared one: bump it to push them again, e.g. after a rotation.",
+	Optional:            true,
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecretsRevision(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var sent *sonarr.DownloadClientResource
+
+	// Sonarr answers with the secrets masked, as it does on every response
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		sent = sonarr.NewDownloadClientResource()
+		assert.NoError(t, json.Unmarshal(body, sent))
+
+		response := *sent
+		response.SetFields([]sonarr.Field{})
+
+		for _, f := range sent.GetFields() {
+			if f.GetName() == "password" {
+				f.SetValue(helpers.SensitiveValue)
+			}
+
+			response.Fields = append(response.Fields, f)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+
+	serverURL, _ := url.Parse(server.URL)
+	auth := context.WithValue(ctx, sonarr.ContextServerVariables, map[string]string{
+		"protocol": serverURL.Scheme,
+		"hostpath": serverURL.Host,
+	})
+	r := &DownloadClientTransmissionResource{client: sonarr.NewAPIClient(sonarr.NewConfiguration()), auth: auth}
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	model := func(revision int64) *DownloadClientTransmission {
+		return &DownloadClientTransmission{
+			Tags:            types.SetValueMust(types.Int64Type, nil),
+			Name:            types.StringValue("Transmission"),
+			Password:        types.StringValue("secret"),
+			ID:              types.Int64Value(1),
+			SecretsRevision: types.Int64Value(revision),
+		}
+	}
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	state := tfsdk.State{Schema: schemaResp.Schema}
+
+	assert.False(t, plan.Set(ctx, model(2)).HasError())
+	assert.False(t, state.Set(ctx, model(1)).HasError())
+
+	// bumping the revision is the only change, which is enough for Terraform to plan an update
+	assert.False(t, plan.Raw.Equal(state.Raw))
+
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.Update(ctx, resource.UpdateRequest{Plan: plan, State: state}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	// the configured secret is pushed again, even if Sonarr only ever returned it masked
+	password, ok := importListField(sent.GetFields(), "password")
+	assert.True(t, ok)
+	assert.Equal(t, "secret", password)
+
+	var result DownloadClientTransmission
+
+	assert.False(t, resp.State.Get(ctx, &result).HasError())
+	assert.Equal(t, types.StringValue("secret"), result.Password)
+	assert.Equal(t, types.Int64Value(2), result.SecretsRevision)
+}
@@ -266,7 +266,7 @@ func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, seriesResourceName, findSeriesIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+seriesResourceName+": "+req.ID)
 }
 
@@ -297,9 +297,18 @@ func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Seri
 	series.SetMonitored(s.Monitored.ValueBool())
 	series.SetSeasonFolder(s.SeasonFolder.ValueBool())
 	series.SetPath(s.Path.ValueString())
-	series.SetRootFolderPath(s.Path.ValueString())
+	series.SetRootFolderPath(s.RootFolderPath.ValueString())
 	series.SetUseSceneNumbering(s.UseSceneNumbering.ValueBool())
 	diags.Append(s.Tags.ElementsAs(ctx, &series.Tags, true)...)
 
 	return series
 }
+
+// findSeriesIDs returns a function listing the IDs of the series with the given title.
+func findSeriesIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.SeriesResource, error) {
+		response, _, err := client.SeriesAPI.ListSeries(auth).Execute()
+
+		return response, err
+	}, (*sonarr.SeriesResource).GetTitle, (*sonarr.SeriesResource).GetId, nil)
+}
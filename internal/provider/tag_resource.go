@@ -34,8 +34,9 @@ func NewTagResource() resource.Resource {
 
 // TagResource defines the tag implementation.
 type TagResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client        *sonarr.APIClient
+	auth          context.Context
+	adoptExisting bool
 }
 
 // Tag describes the tag data model.
@@ -82,9 +83,10 @@ func (r *TagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 }
 
 func (r *TagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceProviderData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.adoptExisting = providerData.AdoptExisting
 	}
 }
 
@@ -102,7 +104,7 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request := *sonarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	response, err := createTag(r.auth, r.client, &request, r.adoptExisting, &resp.Diagnostics)
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
 
@@ -189,7 +191,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *TagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	helpers.ImportStatePassthroughIntIDOrName(ctx, path.Root("id"), req, resp, tagResourceName, findTagIDs(r.auth, r.client))
 	tflog.Trace(ctx, "imported "+tagResourceName+": "+req.ID)
 }
 
@@ -197,3 +199,12 @@ func (t *Tag) write(tag *sonarr.TagResource) {
 	t.ID = types.Int64Value(int64(tag.GetId()))
 	t.Label = types.StringValue(tag.GetLabel())
 }
+
+// findTagIDs returns a function listing the IDs of the tags with the given label.
+func findTagIDs(auth context.Context, client *sonarr.APIClient) func(string) ([]int32, error) {
+	return helpers.FindIDs(func() ([]sonarr.TagResource, error) {
+		response, _, err := client.TagAPI.ListTag(auth).Execute()
+
+		return response, err
+	}, (*sonarr.TagResource).GetLabel, (*sonarr.TagResource).GetId, nil)
+}
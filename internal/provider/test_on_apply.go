@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testOnApplyAttribute is the resource attribute enabling the connectivity test before saving.
+var testOnApplyAttribute = schema.BoolAttribute{
+	MarkdownDescription: "Run the Sonarr connectivity test before saving, reporting its validation failures. Defaults to the provider `test_on_apply` value.",
+	Optional:            true,
+}
+
+// validationFailure is a single failure returned by the Sonarr test endpoints.
+type validationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
+// testOnApply returns the resource test_on_apply value, falling back to the provider default.
+func testOnApply(attribute types.Bool, providerDefault bool) bool {
+	if attribute.IsNull() || attribute.IsUnknown() {
+		return providerDefault
+	}
+
+	return attribute.ValueBool()
+}
+
+// testDownloadClient runs the download client connectivity test and reports whether it passed.
+func testDownloadClient(auth context.Context, client *sonarr.APIClient, request *sonarr.DownloadClientResource, name string, fieldContainer interface{}, diags *diag.Diagnostics) bool {
+	_, err := client.DownloadClientAPI.TestDownloadClient(auth).DownloadClientResource(*request).Execute()
+
+	return parseTestResult(err, name, fieldContainer, downloadClientFields, diags)
+}
+
+// testIndexer runs the indexer connectivity test and reports whether it passed.
+func testIndexer(auth context.Context, client *sonarr.APIClient, request *sonarr.IndexerResource, name string, fieldContainer interface{}, diags *diag.Diagnostics) bool {
+	_, err := client.IndexerAPI.TestIndexer(auth).IndexerResource(*request).Execute()
+
+	return parseTestResult(err, name, fieldContainer, indexerFields, diags)
+}
+
+// testNotification runs the notification connectivity test and reports whether it passed.
+func testNotification(auth context.Context, client *sonarr.APIClient, request *sonarr.NotificationResource, name string, fieldContainer interface{}, diags *diag.Diagnostics) bool {
+	_, err := client.NotificationAPI.TestNotification(auth).NotificationResource(*request).Execute()
+
+	return parseTestResult(err, name, fieldContainer, notificationFields, diags)
+}
+
+// testImportList runs the import list connectivity test and reports whether it passed.
+func testImportList(auth context.Context, client *sonarr.APIClient, request *sonarr.ImportListResource, name string, fieldContainer interface{}, diags *diag.Diagnostics) bool {
+	_, err := client.ImportListAPI.TestImportList(auth).ImportListResource(*request).Execute()
+
+	return parseTestResult(err, name, fieldContainer, importListFields, diags)
+}
+
+// testMetadata runs the metadata connectivity test and reports whether it passed.
+func testMetadata(auth context.Context, client *sonarr.APIClient, request *sonarr.MetadataResource, name string, fieldContainer interface{}, diags *diag.Diagnostics) bool {
+	_, err := client.MetadataAPI.TestMetadata(auth).MetadataResource(*request).Execute()
+
+	return parseTestResult(err, name, fieldContainer, metadataFields, diags)
+}
+
+// parseTestResult maps the Sonarr validation failures to attribute diagnostics.
+// Failures on properties not managed by any attribute are reported at resource level.
+func parseTestResult(err error, name string, fieldContainer interface{}, fieldLists helpers.Fields, diags *diag.Diagnostics) bool {
+	if err == nil {
+		return true
+	}
+
+	var (
+		apiErr   *sonarr.GenericOpenAPIError
+		failures []validationFailure
+	)
+
+	if !errors.As(err, &apiErr) || json.Unmarshal(apiErr.Body(), &failures) != nil || len(failures) == 0 {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.TestConnectivity, name, err))
+
+		return false
+	}
+
+	passed := true
+
+	for _, failure := range failures {
+		attribute := failureAttribute(failure.PropertyName, fieldContainer, fieldLists)
+
+		message := failure.ErrorMessage
+		if attribute == "" && failure.PropertyName != "" {
+			message = failure.PropertyName + ": " + message
+		}
+
+		switch {
+		case failure.IsWarning && attribute != "":
+			diags.AddAttributeWarning(path.Root(attribute), helpers.ConnectivityTestWarning, message)
+		case failure.IsWarning:
+			diags.AddWarning(helpers.ConnectivityTestWarning, message)
+		case attribute != "":
+			passed = false
+
+			diags.AddAttributeError(path.Root(attribute), helpers.ConnectivityTestError, message)
+		default:
+			passed = false
+
+			diags.AddError(helpers.ConnectivityTestError, message)
+		}
+	}
+
+	return passed
+}
+
+// failureAttribute returns the attribute matching the failure property, either a field or a top level one.
+func failureAttribute(property string, fieldContainer interface{}, fieldLists helpers.Fields) string {
+	if property == "" {
+		return ""
+	}
+
+	// nested settings are reported with their full path
+	property = property[strings.LastIndex(property, ".")+1:]
+	if attribute := helpers.AttributeName(property, fieldContainer, fieldLists); attribute != "" {
+		return attribute
+	}
+
+	return helpers.StructAttributeName(property, fieldContainer)
+}
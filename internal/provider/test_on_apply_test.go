@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestOnApply(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		attribute       types.Bool
+		providerDefault bool
+		expected        bool
+	}{
+		"provider_default": {
+			attribute:       types.BoolNull(),
+			providerDefault: true,
+			expected:        true,
+		},
+		"override": {
+			attribute:       types.BoolValue(false),
+			providerDefault: true,
+			expected:        false,
+		},
+		"enabled": {
+			attribute: types.BoolValue(true),
+			expected:  true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, testOnApply(test.attribute, test.providerDefault))
+		})
+	}
+}
+
+func TestTestDownloadClient(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		status   int
+		body     string
+		passed   bool
+		expected diag.Diagnostics
+	}{
+		"success": {
+			status:   http.StatusOK,
+			body:     `{}`,
+			passed:   true,
+			expected: nil,
+		},
+		"warning": {
+			status: http.StatusBadRequest,
+			body:   `[{"propertyName":"Host","errorMessage":"slow","isWarning":true}]`,
+			passed: true,
+			expected: diag.Diagnostics{
+				diag.NewAttributeWarningDiagnostic(path.Root("host"), "Connectivity Test Warning", "slow"),
+			},
+		},
+		"field_error": {
+			status: http.StatusBadRequest,
+			body:   `[{"propertyName":"Password","errorMessage":"wrong password","isWarning":false}]`,
+			passed: false,
+			expected: diag.Diagnostics{
+				diag.NewAttributeErrorDiagnostic(path.Root("password"), "Connectivity Test Error", "wrong password"),
+			},
+		},
+		"unmapped_error": {
+			status: http.StatusBadRequest,
+			body:   `[{"propertyName":"","errorMessage":"unreachable","isWarning":false}]`,
+			passed: false,
+			expected: diag.Diagnostics{
+				diag.NewErrorDiagnostic("Connectivity Test Error", "unreachable"),
+			},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v3/downloadclient/test", r.URL.Path)
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(test.status)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client, auth := testFakeClient(server.URL)
+
+			var diags diag.Diagnostics
+
+			passed := testDownloadClient(auth, client, sonarr.NewDownloadClientResource(), downloadClientResourceName, &DownloadClient{}, &diags)
+			assert.Equal(t, test.passed, passed)
+			assert.Equal(t, test.expected, diags)
+		})
+	}
+}